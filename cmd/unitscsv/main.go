@@ -0,0 +1,25 @@
+/*
+The unitscsv command converts the quantities in selected columns of CSV or
+TSV data to a single unit, using the csvconv package. The data is read from
+the standard input and written to the standard output one record at a time
+so files of any size can be converted.
+
+Each column to convert is given with the -col flag, naming the column in
+the header and the target unit, qualified by its family. For instance:
+
+	unitscsv -col weight=mass:kg -col height=distance:metre < in.csv
+
+Use the -col-index flag instead to select a column by its index, counting
+from zero, as must be done if the data has no header (see -no-header).
+
+Values which cannot be converted are written unchanged and reported on the
+standard error, as are records which cannot be parsed. The exit status is
+1 if anything could not be converted and 2 if the arguments are invalid.
+*/
+package main
+
+import "os"
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
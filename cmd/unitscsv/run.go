@@ -0,0 +1,151 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/nickwells/units.mod/v2/units"
+	"github.com/nickwells/units.mod/v2/units/csvconv"
+)
+
+// These are the exit statuses of the command
+const (
+	exitOK       = 0
+	exitConvErr  = 1
+	exitUsageErr = 2
+)
+
+// parseTarget returns the unit given by a target in the form
+// "family:unit", for instance "mass:kg"
+func parseTarget(target string) (units.Unit, error) {
+	fName, uName, ok := strings.Cut(target, ":")
+	if !ok {
+		return units.Unit{},
+			fmt.Errorf("bad target unit %q: it should be family:unit", target)
+	}
+
+	f, err := units.GetFamily(fName)
+	if err != nil {
+		return units.Unit{}, err
+	}
+
+	return f.GetUnit(uName)
+}
+
+// parseColSpec splits a column specification, in the form
+// "column=family:unit", into the column and the target unit
+func parseColSpec(spec string) (string, units.Unit, error) {
+	i := strings.LastIndex(spec, "=")
+	if i < 0 {
+		return "", units.Unit{},
+			fmt.Errorf("bad column %q: it should be column=family:unit", spec)
+	}
+
+	u, err := parseTarget(spec[i+1:])
+	if err != nil {
+		return "", units.Unit{}, fmt.Errorf("bad column %q: %w", spec, err)
+	}
+
+	return spec[:i], u, nil
+}
+
+// addFlags adds the flags to the FlagSet. The options they give are
+// appended to opts.
+func addFlags(fs *flag.FlagSet, opts *[]csvconv.Opt) {
+	fs.Func("col",
+		"a column to convert, given by name as column=family:unit",
+		func(spec string) error {
+			name, u, err := parseColSpec(spec)
+			if err != nil {
+				return err
+			}
+
+			*opts = append(*opts, csvconv.OptColumn(name, u))
+
+			return nil
+		})
+	fs.Func("col-index",
+		"a column to convert, given by index as index=family:unit",
+		func(spec string) error {
+			idxStr, u, err := parseColSpec(spec)
+			if err != nil {
+				return err
+			}
+
+			idx, err := strconv.Atoi(idxStr)
+			if err != nil {
+				return fmt.Errorf("bad column index %q", idxStr)
+			}
+
+			*opts = append(*opts, csvconv.OptColumnIndex(idx, u))
+
+			return nil
+		})
+	fs.BoolFunc("tsv", "the fields are separated by tabs",
+		func(string) error {
+			*opts = append(*opts, csvconv.OptTSV())
+			return nil
+		})
+	fs.BoolFunc("no-header", "the data has no header row",
+		func(string) error {
+			*opts = append(*opts, csvconv.OptNoHeader())
+			return nil
+		})
+	fs.BoolFunc("split-unit",
+		"write the converted value and its unit in separate columns",
+		func(string) error {
+			*opts = append(*opts, csvconv.OptSplitUnit())
+			return nil
+		})
+}
+
+// run converts the data read from in, writing the result to out and any
+// errors to errOut, and returns the exit status
+func run(args []string, in io.Reader, out, errOut io.Writer) int {
+	var opts []csvconv.Opt
+
+	fs := flag.NewFlagSet("unitscsv", flag.ContinueOnError)
+	fs.SetOutput(errOut)
+	addFlags(fs, &opts)
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+
+		return exitUsageErr
+	}
+
+	if fs.NArg() > 0 {
+		fmt.Fprintf(errOut, "unexpected arguments: %q\n", fs.Args())
+		return exitUsageErr
+	}
+
+	opts = append(opts, csvconv.OptErrorHandler(func(e csvconv.RowError) {
+		fmt.Fprintln(errOut, e)
+	}))
+
+	c, err := csvconv.New(opts...)
+	if err != nil {
+		fmt.Fprintln(errOut, err)
+		return exitUsageErr
+	}
+
+	sum, err := c.Convert(in, out)
+	if err != nil {
+		fmt.Fprintln(errOut, err)
+		return exitConvErr
+	}
+
+	if sum.Errors > 0 {
+		fmt.Fprintf(errOut, "rows: %d, converted: %d, errors: %d\n",
+			sum.Rows, sum.Converted, sum.Errors)
+		return exitConvErr
+	}
+
+	return exitOK
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestRun(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		args      []string
+		in        string
+		expStatus int
+		expOut    string
+		expErrOut string
+	}{
+		{
+			ID:   testhelper.MkID("by name"),
+			args: []string{"-col", "weight=mass:kg"},
+			in: "id,weight\n" +
+				"1,10 lb\n" +
+				"2,5.5 kg\n",
+			expOut: "id,weight\n" +
+				"1,4.5359237 kg\n" +
+				"2,5.5 kg\n",
+		},
+		{
+			ID: testhelper.MkID("TSV, by index, no header, split unit"),
+			args: []string{
+				"-tsv", "-no-header", "-split-unit",
+				"-col-index", "1=distance:metre",
+			},
+			in:     "a\t3 ft\n",
+			expOut: "a\t0.9144\tm\n",
+		},
+		{
+			ID:   testhelper.MkID("bad values"),
+			args: []string{"-col", "weight=mass:kg"},
+			in: "id,weight\n" +
+				"1,heavy\n" +
+				"2,3 \"kg\n" +
+				"3,1000 g\n",
+			expStatus: exitConvErr,
+			expOut: "id,weight\n" +
+				"1,heavy\n" +
+				"3,1 kg\n",
+			expErrOut: `row 2, column weight: "heavy":` +
+				` "heavy" does not start with a number` + "\n" +
+				`row 3: parse error on line 3, column 5:` +
+				` bare " in non-quoted-field` + "\n" +
+				"rows: 3, converted: 1, errors: 2\n",
+		},
+		{
+			ID:        testhelper.MkID("missing column"),
+			args:      []string{"-col", "mass=mass:kg"},
+			in:        "id,weight\n",
+			expStatus: exitConvErr,
+			expOut:    "",
+			expErrOut: `there is no column called "mass" in the header` + "\n",
+		},
+		{
+			ID:        testhelper.MkID("no columns"),
+			expStatus: exitUsageErr,
+			expErrOut: "no columns have been selected for conversion\n",
+		},
+		{
+			ID:        testhelper.MkID("extra arguments"),
+			args:      []string{"-col", "weight=mass:kg", "in.csv"},
+			expStatus: exitUsageErr,
+			expErrOut: `unexpected arguments: ["in.csv"]` + "\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		var out, errOut strings.Builder
+
+		status := run(tc.args, strings.NewReader(tc.in), &out, &errOut)
+		testhelper.DiffInt(t, tc.IDStr(), "exit status", status, tc.expStatus)
+		testhelper.DiffString(t, tc.IDStr(), "output", out.String(), tc.expOut)
+		testhelper.DiffString(t, tc.IDStr(), "error output",
+			errOut.String(), tc.expErrOut)
+	}
+}
+
+func TestParseColSpec(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		spec    string
+		expCol  string
+		expUnit string
+	}{
+		{
+			ID:      testhelper.MkID("good"),
+			spec:    "weight=mass:kg",
+			expCol:  "weight",
+			expUnit: "kg",
+		},
+		{
+			ID:      testhelper.MkID("rate family"),
+			spec:    "flow=volume/time:litre/hour",
+			expCol:  "flow",
+			expUnit: "litre/hour",
+		},
+		{
+			ID:   testhelper.MkID("no target"),
+			spec: "weight",
+			ExpErr: testhelper.MkExpErr(
+				`bad column "weight": it should be column=family:unit`),
+		},
+		{
+			ID:   testhelper.MkID("no family"),
+			spec: "weight=kg",
+			ExpErr: testhelper.MkExpErr(
+				`bad target unit "kg": it should be family:unit`),
+		},
+		{
+			ID:   testhelper.MkID("bad unit"),
+			spec: "weight=mass:furlong",
+			ExpErr: testhelper.MkExpErr(
+				`there is no unit of mass called "furlong"`),
+		},
+	}
+
+	for _, tc := range testCases {
+		col, u, err := parseColSpec(tc.spec)
+		if testhelper.CheckExpErr(t, err, tc) && err == nil {
+			testhelper.DiffString(t, tc.IDStr(), "column", col, tc.expCol)
+			testhelper.DiffString(t, tc.IDStr(), "unit", u.ID(), tc.expUnit)
+		}
+	}
+}
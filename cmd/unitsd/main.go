@@ -0,0 +1,41 @@
+/*
+The unitsd command runs an HTTP server giving access to the units package so
+that services not written in Go can use the same conversions. The responses
+are JSON; the API is described by the OpenAPI document served at
+/openapi.json.
+
+The endpoints are:
+
+	GET /families
+	GET /families/{name}/units
+	GET /convert?value=&from=&to=&family=
+	GET /parse?s=&family=[&to=]
+	GET /openapi.json
+
+Use the -addr flag to set the address to listen on.
+*/
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"time"
+)
+
+// readHeaderTimeout limits the time allowed to read the request headers
+const readHeaderTimeout = 10 * time.Second
+
+func main() {
+	addr := flag.String("addr", "localhost:8080", "the address to listen on")
+	flag.Parse()
+
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           newHandler(),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	log.Printf("unitsd listening on %s", *addr)
+	log.Fatal(srv.ListenAndServe())
+}
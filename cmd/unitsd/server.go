@@ -0,0 +1,334 @@
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/nickwells/units.mod/v2/units"
+)
+
+//go:embed openapi.json
+var openAPIDoc []byte
+
+// errNotFinite is returned if the result of a conversion is not a finite
+// number
+var errNotFinite = errors.New("the result is not a finite number")
+
+// familyJSON is the JSON form of a units.Family
+type familyJSON struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	BaseUnit    string   `json:"baseUnit"`
+	Aliases     []string `json:"aliases,omitempty"`
+}
+
+// unitJSON is the JSON form of a units.Unit
+type unitJSON struct {
+	ID                string  `json:"id"`
+	Name              string  `json:"name"`
+	NamePlural        string  `json:"namePlural"`
+	Abbrev            string  `json:"abbrev"`
+	Symbol            string  `json:"symbol"`
+	ConvFactor        float64 `json:"convFactor"`
+	ConversionFormula string  `json:"conversionFormula"`
+	Notes             string  `json:"notes,omitempty"`
+}
+
+// unitsJSON is the response to a request for the units of a Family
+type unitsJSON struct {
+	Family string     `json:"family"`
+	Units  []unitJSON `json:"units"`
+}
+
+// convertJSON is the response to a conversion request
+type convertJSON struct {
+	Family string   `json:"family"`
+	Value  float64  `json:"value"`
+	From   unitJSON `json:"from"`
+	To     unitJSON `json:"to"`
+	Result float64  `json:"result"`
+}
+
+// parseJSON is the response to a parse request
+type parseJSON struct {
+	Family  string       `json:"family"`
+	Value   float64      `json:"value"`
+	Unit    unitJSON     `json:"unit"`
+	SigFigs int          `json:"sigFigs"`
+	Result  *convertJSON `json:"converted,omitempty"`
+}
+
+// errorJSON is the response to a request which fails
+type errorJSON struct {
+	Error string `json:"error"`
+}
+
+// newHandler returns the handler serving the API
+func newHandler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /families", handleFamilies)
+	mux.HandleFunc("GET /families/{name}/units", handleUnits)
+	mux.HandleFunc("GET /convert", handleConvert)
+	mux.HandleFunc("GET /parse", handleParse)
+	mux.HandleFunc("GET /openapi.json", handleOpenAPI)
+
+	return mux
+}
+
+// writeJSON writes the value as the JSON response with the given status. The
+// value is encoded before anything is written so that an encoding error can
+// still be reported to the client.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(v); err != nil {
+		status = http.StatusInternalServerError
+		buf.Reset()
+		_ = json.NewEncoder(&buf).Encode(
+			errorJSON{Error: "cannot encode the response: " + err.Error()})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(buf.Bytes())
+}
+
+// writeError writes the error as the JSON response with the given status.
+// If the error is that a result is not finite the status is changed to
+// show that the request was understood but could not be satisfied.
+func writeError(w http.ResponseWriter, status int, err error) {
+	if errors.Is(err, errNotFinite) {
+		status = http.StatusUnprocessableEntity
+	}
+
+	writeJSON(w, status, errorJSON{Error: err.Error()})
+}
+
+// getFamily returns the named Family. The name must be that of one of the
+// standard Families (or one of their aliases) or of a rate made from two of
+// them, such as "volume/time". Rates of rates are not allowed.
+func getFamily(name string) (*units.Family, error) {
+	parts := strings.Split(name, "/")
+	if len(parts) > 2 { //nolint:mnd
+		return nil,
+			fmt.Errorf("there is no unit family called %q"+
+				" (only a rate of two families is allowed)", name)
+	}
+
+	for _, p := range parts {
+		if _, err := units.GetFamily(p); err != nil {
+			return nil, err
+		}
+	}
+
+	return units.GetFamily(name)
+}
+
+// mkUnitJSON returns the JSON form of the Unit
+func mkUnitJSON(u units.Unit) unitJSON {
+	return unitJSON{
+		ID:                u.ID(),
+		Name:              u.Name(),
+		NamePlural:        u.NamePlural(),
+		Abbrev:            u.Abbrev(),
+		Symbol:            u.Symbol(),
+		ConvFactor:        u.ConvFactor(),
+		ConversionFormula: u.ConversionFormula(),
+		Notes:             u.Notes(),
+	}
+}
+
+// handleFamilies lists the Families, in order of name
+func handleFamilies(w http.ResponseWriter, _ *http.Request) {
+	families := units.GetFamilies()
+	slices.SortFunc(families, func(a, b *units.Family) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+
+	rval := make([]familyJSON, 0, len(families))
+	for _, f := range families {
+		rval = append(rval, familyJSON{
+			Name:        f.Name(),
+			Description: f.Description(),
+			BaseUnit:    f.BaseUnitName(),
+			Aliases:     f.FamilyAliases(),
+		})
+	}
+
+	writeJSON(w, http.StatusOK, rval)
+}
+
+// handleUnits lists the units of the named Family, in order of ID
+func handleUnits(w http.ResponseWriter, r *http.Request) {
+	f, err := getFamily(r.PathValue("name"))
+	if err != nil {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+
+	us := f.GetUnits()
+	slices.SortFunc(us, func(a, b units.Unit) int {
+		return strings.Compare(a.ID(), b.ID())
+	})
+
+	rval := unitsJSON{Family: f.Name(), Units: make([]unitJSON, 0, len(us))}
+	for _, u := range us {
+		rval.Units = append(rval.Units, mkUnitJSON(u))
+	}
+
+	writeJSON(w, http.StatusOK, rval)
+}
+
+// requiredParam returns the value of the named query parameter. A non-nil
+// error is returned if it is missing.
+func requiredParam(r *http.Request, name string) (string, error) {
+	v := r.URL.Query().Get(name)
+	if v == "" {
+		return "", fmt.Errorf("the %q parameter is missing", name)
+	}
+
+	return v, nil
+}
+
+// convert converts the value into the named unit of the Family
+func convert(f *units.Family, vu units.ValUnit, to string) (convertJSON, error) {
+	toU, err := f.GetUnit(to)
+	if err != nil {
+		return convertJSON{}, err
+	}
+
+	res, err := vu.Convert(toU)
+	if err != nil {
+		return convertJSON{}, err
+	}
+
+	if math.IsNaN(res.V) || math.IsInf(res.V, 0) {
+		return convertJSON{},
+			fmt.Errorf("cannot convert %v %s to %s: %w",
+				vu.V, vu.U.NamePlural(), toU.NamePlural(), errNotFinite)
+	}
+
+	return convertJSON{
+		Family: f.Name(),
+		Value:  vu.V,
+		From:   mkUnitJSON(vu.U),
+		To:     mkUnitJSON(toU),
+		Result: res.V,
+	}, nil
+}
+
+// handleConvert converts a value from one unit to another
+func handleConvert(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{}
+
+	for _, name := range []string{"value", "from", "to", "family"} {
+		v, err := requiredParam(r, name)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		params[name] = v
+	}
+
+	v, err := strconv.ParseFloat(params["value"], 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest,
+			fmt.Errorf("bad value %q: %w", params["value"], err))
+
+		return
+	}
+
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		writeError(w, http.StatusBadRequest,
+			fmt.Errorf("bad value %q: the value must be a finite number",
+				params["value"]))
+
+		return
+	}
+
+	f, err := getFamily(params["family"])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	from, err := f.GetUnit(params["from"])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	rval, err := convert(f, units.ValUnit{V: v, U: from}, params["to"])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, rval)
+}
+
+// handleParse parses a value with a unit, such as "5.0 ft", and converts
+// it if a target unit is given
+func handleParse(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{}
+
+	for _, name := range []string{"s", "family"} {
+		v, err := requiredParam(r, name)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		params[name] = v
+	}
+
+	f, err := getFamily(params["family"])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	pvu, err := f.ParseValUnit(params["s"])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	rval := parseJSON{
+		Family:  f.Name(),
+		Value:   pvu.V,
+		Unit:    mkUnitJSON(pvu.U),
+		SigFigs: pvu.SigFigs,
+	}
+
+	if to := r.URL.Query().Get("to"); to != "" {
+		res, err := convert(f, pvu.ValUnit, to)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		rval.Result = &res
+	}
+
+	writeJSON(w, http.StatusOK, rval)
+}
+
+// handleOpenAPI serves the OpenAPI document describing the API
+func handleOpenAPI(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(openAPIDoc)
+}
@@ -0,0 +1,364 @@
+package main
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+// get makes a GET request of the handler and returns the response
+func get(t *testing.T, id, target string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	rec := httptest.NewRecorder()
+	newHandler().ServeHTTP(rec, req)
+
+	testhelper.DiffString(t, id, "content type",
+		rec.Header().Get("Content-Type"), "application/json")
+
+	return rec
+}
+
+func TestFamilies(t *testing.T) {
+	const id = "GET /families"
+
+	rec := get(t, id, "/families")
+	testhelper.DiffInt(t, id, "status", rec.Code, http.StatusOK)
+
+	var families []familyJSON
+	if err := json.Unmarshal(rec.Body.Bytes(), &families); err != nil {
+		t.Fatal(id, ": bad JSON: ", err)
+	}
+
+	found := false
+
+	for i, f := range families {
+		if i > 0 && families[i-1].Name >= f.Name {
+			t.Error(id, ": the families are not in order: ",
+				families[i-1].Name, " >= ", f.Name)
+		}
+
+		if f.Name == "distance" {
+			found = true
+
+			testhelper.DiffString(t, id, "distance base unit",
+				f.BaseUnit, "metre")
+		}
+	}
+
+	if !found {
+		t.Error(id, ": the distance family is missing")
+	}
+}
+
+func TestUnits(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		target    string
+		expStatus int
+		expFamily string
+		expUnit   string
+		expError  string
+	}{
+		{
+			ID:        testhelper.MkID("good"),
+			target:    "/families/distance/units",
+			expStatus: http.StatusOK,
+			expFamily: "distance",
+			expUnit:   "foot",
+		},
+		{
+			ID:        testhelper.MkID("family alias"),
+			target:    "/families/length/units",
+			expStatus: http.StatusOK,
+			expFamily: "distance",
+			expUnit:   "foot",
+		},
+		{
+			ID:        testhelper.MkID("rate family"),
+			target:    "/families/distance%2Ftime/units",
+			expStatus: http.StatusOK,
+			expFamily: "distance/time",
+			expUnit:   "metre/second",
+		},
+		{
+			ID:        testhelper.MkID("rate of a rate"),
+			target:    "/families/distance%2Ftime%2Ftime/units",
+			expStatus: http.StatusNotFound,
+			expError: `there is no unit family called "distance/time/time"` +
+				` (only a rate of two families is allowed)`,
+		},
+		{
+			ID:        testhelper.MkID("unknown family"),
+			target:    "/families/nonesuch/units",
+			expStatus: http.StatusNotFound,
+			expError:  `there is no unit family called "nonesuch"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		rec := get(t, tc.IDStr(), tc.target)
+		testhelper.DiffInt(t, tc.IDStr(), "status", rec.Code, tc.expStatus)
+
+		if tc.expError != "" {
+			checkError(t, tc.IDStr(), rec, tc.expError)
+			continue
+		}
+
+		var us unitsJSON
+		if err := json.Unmarshal(rec.Body.Bytes(), &us); err != nil {
+			t.Fatal(tc.IDStr(), ": bad JSON: ", err)
+		}
+
+		testhelper.DiffString(t, tc.IDStr(), "family", us.Family, tc.expFamily)
+
+		found := false
+
+		for _, u := range us.Units {
+			if u.ID == tc.expUnit {
+				found = true
+
+				if u.ConversionFormula == "" {
+					t.Error(tc.IDStr(), ": the conversion formula is missing")
+				}
+			}
+		}
+
+		if !found {
+			t.Error(tc.IDStr(), ": unit ", tc.expUnit, " is missing")
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		target    string
+		expStatus int
+		expResult float64
+		expError  string
+	}{
+		{
+			ID:        testhelper.MkID("good"),
+			target:    "/convert?value=2&from=ft&to=inch&family=distance",
+			expStatus: http.StatusOK,
+			expResult: 24,
+		},
+		{
+			ID: testhelper.MkID("temperature"),
+			target: "/convert?value=100&from=C&to=F" +
+				"&family=temperature",
+			expStatus: http.StatusOK,
+			expResult: 212,
+		},
+		{
+			ID:        testhelper.MkID("missing parameter"),
+			target:    "/convert?value=2&from=ft&family=distance",
+			expStatus: http.StatusBadRequest,
+			expError:  `the "to" parameter is missing`,
+		},
+		{
+			ID:        testhelper.MkID("bad value"),
+			target:    "/convert?value=two&from=ft&to=inch&family=distance",
+			expStatus: http.StatusBadRequest,
+			expError: `bad value "two": strconv.ParseFloat:` +
+				` parsing "two": invalid syntax`,
+		},
+		{
+			ID:        testhelper.MkID("NaN value"),
+			target:    "/convert?value=NaN&from=ft&to=inch&family=distance",
+			expStatus: http.StatusBadRequest,
+			expError: `bad value "NaN":` +
+				` the value must be a finite number`,
+		},
+		{
+			ID:        testhelper.MkID("infinite value"),
+			target:    "/convert?value=-Inf&from=ft&to=inch&family=distance",
+			expStatus: http.StatusBadRequest,
+			expError: `bad value "-Inf":` +
+				` the value must be a finite number`,
+		},
+		{
+			ID:        testhelper.MkID("infinite result"),
+			target:    "/convert?value=1e308&from=Ym&to=ym&family=distance",
+			expStatus: http.StatusUnprocessableEntity,
+			expError: "cannot convert 1e+308 yottametres to yoctometres:" +
+				" the result is not a finite number",
+		},
+		{
+			ID: testhelper.MkID("rate of a rate"),
+			target: "/convert?value=1&from=m/s/s&to=m/s/s" +
+				"&family=distance/time/time",
+			expStatus: http.StatusBadRequest,
+			expError: `there is no unit family called "distance/time/time"` +
+				` (only a rate of two families is allowed)`,
+		},
+		{
+			ID:        testhelper.MkID("case of abbreviation matters"),
+			target:    "/convert?value=1&from=MG&to=kg&family=mass",
+			expStatus: http.StatusBadRequest,
+			expError: `there is no unit of mass called "MG",` +
+				` did you mean: "Mg" or "mg" or "Eg"`,
+		},
+		{
+			ID:        testhelper.MkID("bad unit"),
+			target:    "/convert?value=2&from=ft&to=kg&family=distance",
+			expStatus: http.StatusBadRequest,
+			expError: `there is no unit of distance called "kg",` +
+				` did you mean: "km"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		rec := get(t, tc.IDStr(), tc.target)
+		testhelper.DiffInt(t, tc.IDStr(), "status", rec.Code, tc.expStatus)
+
+		if tc.expError != "" {
+			checkError(t, tc.IDStr(), rec, tc.expError)
+			continue
+		}
+
+		var c convertJSON
+		if err := json.Unmarshal(rec.Body.Bytes(), &c); err != nil {
+			t.Fatal(tc.IDStr(), ": bad JSON: ", err)
+		}
+
+		testhelper.DiffFloat(t, tc.IDStr(), "result",
+			c.Result, tc.expResult, 1e-9)
+
+		if c.From.ConversionFormula == "" || c.To.ConversionFormula == "" {
+			t.Error(tc.IDStr(), ": a conversion formula is missing")
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		target     string
+		expStatus  int
+		expValue   float64
+		expUnit    string
+		expSigFigs int
+		expResult  float64
+		expError   string
+	}{
+		{
+			ID:         testhelper.MkID("good"),
+			target:     "/parse?s=5.0+ft&family=distance",
+			expStatus:  http.StatusOK,
+			expValue:   5,
+			expUnit:    "foot",
+			expSigFigs: 2,
+		},
+		{
+			ID:         testhelper.MkID("with conversion"),
+			target:     "/parse?s=1.5+kg&family=mass&to=g",
+			expStatus:  http.StatusOK,
+			expValue:   1.5,
+			expUnit:    "kg",
+			expSigFigs: 2,
+			expResult:  1500,
+		},
+		{
+			ID:        testhelper.MkID("bad value"),
+			target:    "/parse?s=heavy&family=mass",
+			expStatus: http.StatusBadRequest,
+			expError:  `"heavy" does not start with a number`,
+		},
+		{
+			ID:        testhelper.MkID("missing family"),
+			target:    "/parse?s=5+ft",
+			expStatus: http.StatusBadRequest,
+			expError:  `the "family" parameter is missing`,
+		},
+	}
+
+	for _, tc := range testCases {
+		rec := get(t, tc.IDStr(), tc.target)
+		testhelper.DiffInt(t, tc.IDStr(), "status", rec.Code, tc.expStatus)
+
+		if tc.expError != "" {
+			checkError(t, tc.IDStr(), rec, tc.expError)
+			continue
+		}
+
+		var p parseJSON
+		if err := json.Unmarshal(rec.Body.Bytes(), &p); err != nil {
+			t.Fatal(tc.IDStr(), ": bad JSON: ", err)
+		}
+
+		testhelper.DiffFloat(t, tc.IDStr(), "value",
+			p.Value, tc.expValue, 1e-9)
+		testhelper.DiffString(t, tc.IDStr(), "unit", p.Unit.ID, tc.expUnit)
+		testhelper.DiffInt(t, tc.IDStr(), "sig figs",
+			p.SigFigs, tc.expSigFigs)
+
+		if tc.expResult != 0 {
+			if p.Result == nil {
+				t.Error(tc.IDStr(), ": the conversion is missing")
+				continue
+			}
+
+			testhelper.DiffFloat(t, tc.IDStr(), "result",
+				p.Result.Result, tc.expResult, 1e-9)
+		}
+	}
+}
+
+func TestOpenAPI(t *testing.T) {
+	const id = "GET /openapi.json"
+
+	rec := get(t, id, "/openapi.json")
+	testhelper.DiffInt(t, id, "status", rec.Code, http.StatusOK)
+
+	var doc struct {
+		OpenAPI string                    `json:"openapi"`
+		Paths   map[string]map[string]any `json:"paths"`
+	}
+
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatal(id, ": bad JSON: ", err)
+	}
+
+	testhelper.DiffString(t, id, "openapi version", doc.OpenAPI, "3.0.3")
+
+	for _, p := range []string{
+		"/families", "/families/{name}/units", "/convert", "/parse",
+	} {
+		if _, ok := doc.Paths[p]["get"]; !ok {
+			t.Error(id, ": the path is not documented: ", p)
+		}
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	const id = "unencodable value"
+
+	rec := httptest.NewRecorder()
+	writeJSON(rec, http.StatusOK, math.NaN())
+
+	testhelper.DiffInt(t, id, "status", rec.Code,
+		http.StatusInternalServerError)
+	checkError(t, id, rec,
+		"cannot encode the response: json: unsupported value: NaN")
+}
+
+// checkError checks that the response holds the expected error
+func checkError(t *testing.T, id string, rec *httptest.ResponseRecorder,
+	expErr string,
+) {
+	t.Helper()
+
+	var e errorJSON
+	if err := json.Unmarshal(rec.Body.Bytes(), &e); err != nil {
+		t.Fatal(id, ": bad JSON: ", err)
+	}
+
+	testhelper.DiffString(t, id, "error", e.Error, expErr)
+}
@@ -86,3 +86,31 @@ var angleNames = map[string]Unit{
 		"", "",
 	},
 }
+
+// angleProvenance records the source of the conversion factor for each unit
+// of angular measure
+var angleProvenance = map[string]Provenance{
+	bunAngle:      provSI,
+	"milliradian": provSI,
+	"gradian":     provNISTSP811,
+	"degree":      provSI,
+	"minute":      provSI,
+	"second":      provSI,
+}
+
+// angleUCUM records the UCUM codes of those units of angle which have one
+var angleUCUM = map[string]string{
+	bunAngle:      "rad",
+	"milliradian": "mrad",
+	"degree":      "deg",
+	"minute":      "'",
+	"second":      "''",
+	"gradian":     "gon",
+}
+
+// angleExternalIDs records the identifiers of the units of angle in external
+// schemes (see Unit.ExternalIDs)
+var angleExternalIDs = map[string]map[string]string{
+	bunAngle: {SchemeQUDT: "RAD", SchemeWikidata: "Q33680", SchemeOM: "radian"},
+	"degree": {SchemeQUDT: "DEG", SchemeWikidata: "Q28390", SchemeOM: "degree"},
+}
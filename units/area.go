@@ -249,3 +249,72 @@ var areaNames = map[string]Unit{
 		"", "",
 	},
 }
+
+// areaProvenance records the source of the conversion factor for each unit
+// of area
+var areaProvenance = map[string]Provenance{
+	bunArea:            provSI,
+	"are":              provNISTSP811,
+	"decare":           provNISTSP811,
+	"hectare":          provSI,
+	"square kilometre": provSI,
+	"square foot":      provIntlYard,
+	"square yard":      provIntlYard,
+	"square mile":      provIntlYard,
+	"perch":            provIntlYard,
+	"rood":             provIntlYard,
+	"acre":             provIntlYard,
+	"oxgang":           provHistorical,
+	"virgate":          provHistorical,
+	"carucate":         provHistorical,
+	"Wales": {
+		Kind: ConvMeasured, Source: SrcCommonUsage, SigDigits: 5,
+	},
+	"football pitch":          provColloquial,
+	"American football pitch": provColloquial,
+}
+
+// areaSymbols records the typographic symbols of those units of area whose
+// symbols differ from their abbreviations
+var areaSymbols = map[string]string{
+	"square foot": "ft²",
+}
+
+// areaUCUM records the UCUM codes of those units of area which have one
+var areaUCUM = map[string]string{
+	bunArea:            "m2",
+	"square kilometre": "km2",
+	"square foot":      "[sft_i]",
+	"square yard":      "[syd_i]",
+	"square mile":      "[mi_i]2",
+	"are":              "ar",
+	"decare":           "daar",
+	"hectare":          "har",
+	"acre":             "[acr_br]",
+}
+
+// areaRec20 records the UN/ECE Recommendation 20 codes of those units of area
+// which have one
+var areaRec20 = map[string]string{
+	bunArea:            "MTK",
+	"square kilometre": "KMK",
+	"square foot":      "FTK",
+	"square yard":      "YDK",
+	"square mile":      "MIK",
+	"are":              "ARE",
+	"decare":           "DAA",
+	"hectare":          "HAR",
+	"acre":             "ACR",
+}
+
+// areaExternalIDs records the identifiers of the units of area in external
+// schemes (see Unit.ExternalIDs)
+var areaExternalIDs = map[string]map[string]string{
+	bunArea: {
+		SchemeQUDT: "M2", SchemeWikidata: "Q25343", SchemeOM: "squareMetre",
+	},
+	"hectare": {
+		SchemeQUDT: "HA", SchemeWikidata: "Q35852", SchemeOM: "hectare",
+	},
+	"acre": {SchemeQUDT: "AC", SchemeWikidata: "Q81292"},
+}
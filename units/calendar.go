@@ -0,0 +1,126 @@
+package units
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// maxCalendarMonths is the largest number of calendar months that can be
+// added to a time. It is well beyond the range of years that time.Time can
+// usefully represent.
+const maxCalendarMonths = 12 * 1_000_000_000
+
+// CalendarMonths returns the length of the unit in calendar months and
+// true if the unit is calendar-relative, for instance a month, a quarter
+// or a Gregorian year. The length of such a unit depends on the date from
+// which it is measured and its conversion factor is only an average. For
+// any other unit it returns zero and false.
+func (u Unit) CalendarMonths() (int, bool) {
+	if u.f == nil {
+		return 0, false
+	}
+
+	months, ok := u.f.calendarMonths[u.id]
+
+	return months, ok
+}
+
+// IsFixedLength returns true if the unit always represents the same
+// amount, as given by its conversion factor, and false if it is
+// calendar-relative (see CalendarMonths).
+func (u Unit) IsFixedLength() bool {
+	_, ok := u.CalendarMonths()
+	return !ok
+}
+
+// daysIn returns the number of days in the month
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// addMonths returns the time the given number of calendar months after t.
+// If the day of the month does not exist in the resulting month the last
+// day of that month is used, so that one month after 31 January is 28 (or
+// 29) February rather than early March.
+func addMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	hour, minute, sec := t.Clock()
+
+	m := int(month) - 1 + months
+	year += m / 12 //nolint:mnd
+
+	m %= 12
+	if m < 0 {
+		m += 12
+		year--
+	}
+
+	month = time.Month(m + 1)
+	day = min(day, daysIn(year, month))
+
+	return time.Date(year, month, day, hour, minute, sec, t.Nanosecond(),
+		t.Location())
+}
+
+// AddTo returns the time which is the ValUnit, which must be a time, after
+// the anchor time.
+//
+// Calendar-relative units (see Unit.CalendarMonths) are resolved against
+// the anchor so that, for instance, 3 months from 31 January is 30 April
+// and a year from 29 February is 28 February. Where the day of the month
+// does not exist the last day of the month is used. The value is first
+// converted to months and any fraction of a month is taken as a fraction
+// of the next calendar month, so half a month from 1 February 2025 is 15
+// February and half a century from 31 January 2025 is 31 January 2075.
+// Other units are added as a fixed time.Duration.
+//
+// A non-nil error is returned if the ValUnit is not a time or the result
+// cannot be calculated.
+func (v ValUnit) AddTo(anchor time.Time) (time.Time, error) {
+	if v.U.f != timeFamily {
+		return anchor, fmt.Errorf("%s is not a time", v)
+	}
+
+	months, ok := v.U.CalendarMonths()
+	if !ok {
+		d, err := v.Duration()
+		if err != nil {
+			return anchor, err
+		}
+
+		return anchor.Add(d), nil
+	}
+
+	if math.IsNaN(v.V) || math.Abs(v.V*float64(months)) > maxCalendarMonths {
+		return anchor, fmt.Errorf("%s cannot be added to a date", v)
+	}
+
+	// The value is taken as a number of months before any fraction is
+	// applied so that the fraction is of a month rather than of a (possibly
+	// very long) calendar period.
+	whole, frac := math.Modf(v.V * float64(months))
+	n := int(whole)
+
+	t := addMonths(anchor, n)
+	if frac == 0 {
+		return t, nil
+	}
+
+	next := addMonths(anchor, n+int(math.Copysign(1, frac)))
+
+	return t.Add(time.Duration(math.Abs(frac) * float64(next.Sub(t)))), nil
+}
+
+// SpanFrom returns the exact time.Duration between the anchor time and the
+// time which is the ValUnit after it. See AddTo for details of how
+// calendar-relative units are resolved. As for time.Time.Sub, the result
+// is saturated if it is too long for a time.Duration.
+func (v ValUnit) SpanFrom(anchor time.Time) (time.Duration, error) {
+	t, err := v.AddTo(anchor)
+	if err != nil {
+		return 0, err
+	}
+
+	return t.Sub(anchor), nil
+}
@@ -0,0 +1,204 @@
+package units
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestCalendarMonths(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		u         Unit
+		expMonths int
+		expFixed  bool
+	}{
+		{
+			ID:        testhelper.MkID("month"),
+			u:         GetOrPanic(Time, "month"),
+			expMonths: 1,
+		},
+		{
+			ID:        testhelper.MkID("quarter"),
+			u:         GetOrPanic(Time, "quarters"),
+			expMonths: 3,
+		},
+		{
+			ID:        testhelper.MkID("year"),
+			u:         GetOrPanic(Time, "year"),
+			expMonths: 12,
+		},
+		{
+			ID:       testhelper.MkID("fixed length"),
+			u:        GetOrPanic(Time, "week"),
+			expFixed: true,
+		},
+		{
+			ID:       testhelper.MkID("not a time"),
+			u:        GetOrPanic(Distance, "metre"),
+			expFixed: true,
+		},
+		{
+			ID:       testhelper.MkID("no family"),
+			u:        Unit{},
+			expFixed: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		months, _ := tc.u.CalendarMonths()
+		testhelper.DiffInt(t, tc.IDStr(), "calendar months", months, tc.expMonths)
+		testhelper.DiffBool(t, tc.IDStr(), "fixed length",
+			tc.u.IsFixedLength(), tc.expFixed)
+	}
+}
+
+func TestCalendarMonthsRefersToUnits(t *testing.T) {
+	for _, f := range GetFamilies() {
+		for id := range f.calendarMonths {
+			if _, ok := f.altUnits[id]; !ok {
+				t.Errorf("%s: calendar unit %q is not a unit", f.name, id)
+			}
+		}
+	}
+}
+
+func TestValUnitAddTo(t *testing.T) {
+	date := func(y int, m time.Month, d, h int) time.Time {
+		return time.Date(y, m, d, h, 0, 0, 0, time.UTC)
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		vu      ValUnit
+		anchor  time.Time
+		expTime time.Time
+	}{
+		{
+			ID:      testhelper.MkID("3 months from 31 January"),
+			vu:      ValUnit{V: 3, U: GetOrPanic(Time, "months")},
+			anchor:  date(2025, time.January, 31, 9),
+			expTime: date(2025, time.April, 30, 9),
+		},
+		{
+			ID:      testhelper.MkID("1 month from 31 January, leap year"),
+			vu:      ValUnit{V: 1, U: GetOrPanic(Time, "month")},
+			anchor:  date(2024, time.January, 31, 0),
+			expTime: date(2024, time.February, 29, 0),
+		},
+		{
+			ID:      testhelper.MkID("a year from 29 February"),
+			vu:      ValUnit{V: 1, U: GetOrPanic(Time, "year")},
+			anchor:  date(2024, time.February, 29, 0),
+			expTime: date(2025, time.February, 28, 0),
+		},
+		{
+			ID:      testhelper.MkID("negative quarters"),
+			vu:      ValUnit{V: -5, U: GetOrPanic(Time, "quarter")},
+			anchor:  date(2025, time.May, 31, 0),
+			expTime: date(2024, time.February, 29, 0),
+		},
+		{
+			ID:      testhelper.MkID("half a month"),
+			vu:      ValUnit{V: 0.5, U: GetOrPanic(Time, "month")},
+			anchor:  date(2025, time.February, 1, 0),
+			expTime: date(2025, time.February, 15, 0),
+		},
+		{
+			ID:      testhelper.MkID("minus half a month"),
+			vu:      ValUnit{V: -0.5, U: GetOrPanic(Time, "month")},
+			anchor:  date(2025, time.March, 1, 0),
+			expTime: date(2025, time.February, 15, 0),
+		},
+		{
+			ID:      testhelper.MkID("an eighth of a year"),
+			vu:      ValUnit{V: 0.125, U: GetOrPanic(Time, "year")},
+			anchor:  date(2025, time.January, 1, 0),
+			expTime: date(2025, time.February, 15, 0),
+		},
+		{
+			ID:      testhelper.MkID("half a century"),
+			vu:      ValUnit{V: 0.5, U: GetOrPanic(Time, "century")},
+			anchor:  date(2025, time.January, 31, 0),
+			expTime: date(2075, time.January, 31, 0),
+		},
+		{
+			ID:      testhelper.MkID("half a millennium"),
+			vu:      ValUnit{V: 0.5, U: GetOrPanic(Time, "millennium")},
+			anchor:  date(2025, time.January, 31, 0),
+			expTime: date(2525, time.January, 31, 0),
+		},
+		{
+			ID:      testhelper.MkID("minus 2.5 millennia"),
+			vu:      ValUnit{V: -2.5, U: GetOrPanic(Time, "millennium")},
+			anchor:  date(2025, time.March, 1, 0),
+			expTime: date(-475, time.March, 1, 0),
+		},
+		{
+			ID:      testhelper.MkID("fixed length"),
+			vu:      ValUnit{V: 2, U: GetOrPanic(Time, "fortnight")},
+			anchor:  date(2025, time.January, 31, 0),
+			expTime: date(2025, time.February, 28, 0),
+		},
+		{
+			ID:     testhelper.MkID("not a time"),
+			ExpErr: testhelper.MkExpErr("1 metre is not a time"),
+			vu:     ValUnit{V: 1, U: GetOrPanic(Distance, "metre")},
+		},
+		{
+			ID: testhelper.MkID("too many months"),
+			ExpErr: testhelper.MkExpErr(
+				"cannot be added to a date"),
+			vu: ValUnit{V: 1e9, U: GetOrPanic(Time, "millennium")},
+		},
+	}
+
+	for _, tc := range testCases {
+		got, err := tc.vu.AddTo(tc.anchor)
+		if testhelper.CheckExpErr(t, err, tc) && err == nil {
+			testhelper.DiffString(t, tc.IDStr(), "time",
+				got.Format(time.RFC3339), tc.expTime.Format(time.RFC3339))
+		}
+	}
+}
+
+func TestValUnitSpanFrom(t *testing.T) {
+	month := ValUnit{V: 1, U: GetOrPanic(Time, "month")}
+	day := 24 * time.Hour
+
+	testCases := []struct {
+		testhelper.ID
+		anchor time.Time
+		expDur time.Duration
+	}{
+		{
+			ID:     testhelper.MkID("February"),
+			anchor: time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC),
+			expDur: 28 * day,
+		},
+		{
+			ID:     testhelper.MkID("February, leap year"),
+			anchor: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+			expDur: 29 * day,
+		},
+		{
+			ID:     testhelper.MkID("March"),
+			anchor: time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+			expDur: 31 * day,
+		},
+	}
+
+	for _, tc := range testCases {
+		d, err := month.SpanFrom(tc.anchor)
+		if err != nil {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: unexpected error: %s", err)
+
+			continue
+		}
+
+		testhelper.DiffInt(t, tc.IDStr(), "span", int64(d), int64(tc.expDur))
+	}
+}
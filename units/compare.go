@@ -0,0 +1,229 @@
+package units
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/nickwells/mathutil.mod/v2/mathutil"
+)
+
+// checkHasFamily returns a non-nil error if the unit has no Family, as
+// for the zero Unit
+func checkHasFamily(u Unit) error {
+	if u.f == nil {
+		return fmt.Errorf("the unit %q has no family", u.id)
+	}
+
+	return nil
+}
+
+// baseValues returns the values of the two ValUnits in base units. A
+// non-nil error is returned if the units are in different Families or are
+// invalid.
+func baseValues(a, b ValUnit) (float64, float64, error) {
+	for _, u := range []Unit{a.U, b.U} {
+		if err := checkHasFamily(u); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if a.U.f != b.U.f {
+		return 0, 0,
+			fmt.Errorf(
+				"mismatched unit families. Cannot compare %s with %s",
+				a.U.f.name, b.U.f.name)
+	}
+
+	aBase, err := convertToBaseUnits(a.V, a.U)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	bBase, err := convertToBaseUnits(b.V, b.U)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return aBase, bBase, nil
+}
+
+// Compare returns -1 if a is less than b, 0 if they are equal and +1 if a
+// is greater than b, after converting both to base units. So 1 mile is
+// greater than 1 km. As for cmp.Compare, a NaN is less than any other
+// value. A non-nil error is returned if the units are in different
+// Families or are invalid.
+//
+// Note that, because of rounding in the conversions, values which should
+// be equal may not compare as equal; see AlmostEqual.
+func Compare(a, b ValUnit) (int, error) {
+	aBase, bBase, err := baseValues(a, b)
+	if err != nil {
+		return 0, err
+	}
+
+	return cmp.Compare(aBase, bBase), nil
+}
+
+// CompareOrPanic calls Compare and panics if the error is non-nil,
+// otherwise it returns the result of the comparison.
+func CompareOrPanic(a, b ValUnit) int {
+	c, err := Compare(a, b)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// Tolerance gives the largest difference allowed between two ValUnits which
+// are to be treated as equal. It is either an absolute amount (see
+// AbsTolerance) or a fraction of the larger value (see RelTolerance).
+//
+// The zero value allows no difference at all.
+type Tolerance struct {
+	abs      ValUnit
+	rel      float64
+	relative bool
+}
+
+// AbsTolerance returns a Tolerance allowing values to differ by the
+// ValUnit, for instance 1 mm. For units with offsets, such as degrees
+// Fahrenheit, the ValUnit is taken as a difference so only the conversion
+// factor is applied.
+func AbsTolerance(v ValUnit) Tolerance {
+	return Tolerance{abs: v}
+}
+
+// RelTolerance returns a Tolerance allowing values to differ by the given
+// fraction of the larger of the two values, so a fraction of 0.01 allows a
+// difference of 1%.
+func RelTolerance(fraction float64) Tolerance {
+	return Tolerance{rel: fraction, relative: true}
+}
+
+// epsilon returns the largest difference allowed between the two base unit
+// values
+func (t Tolerance) epsilon(f *Family, aBase, bBase float64) (float64, error) {
+	if t.relative {
+		return t.rel * math.Max(math.Abs(aBase), math.Abs(bBase)), nil
+	}
+
+	if t.abs.U.f == nil {
+		return 0, nil
+	}
+
+	if t.abs.U.f != f {
+		return 0,
+			fmt.Errorf(
+				"mismatched unit families. The tolerance is in %s not %s",
+				t.abs.U.f.name, f.name)
+	}
+
+	return t.abs.V * t.abs.U.convFactor, nil
+}
+
+// AlmostEqual returns true if the two ValUnits are equal to within the
+// Tolerance, after converting both to base units. So, for instance, 1 ft
+// and 0.3048 m are almost equal for any Tolerance other than the zero
+// value. A non-nil error is returned if the units or the Tolerance are in
+// different Families or are invalid.
+func AlmostEqual(a, b ValUnit, t Tolerance) (bool, error) {
+	aBase, bBase, err := baseValues(a, b)
+	if err != nil {
+		return false, err
+	}
+
+	epsilon, err := t.epsilon(a.U.f, aBase, bBase)
+	if err != nil {
+		return false, err
+	}
+
+	return mathutil.AlmostEqual(aBase, bBase, epsilon), nil
+}
+
+// checkSameFamily returns a non-nil error if the ValUnits are not all in
+// the same Family
+func checkSameFamily(vs []ValUnit) error {
+	for i, v := range vs {
+		if err := checkHasFamily(v.U); err != nil {
+			return fmt.Errorf("value %d: %w", i, err)
+		}
+
+		if v.U.f != vs[0].U.f {
+			return fmt.Errorf(
+				"mismatched unit families. Value %d is in %s not %s",
+				i, v.U.f.name, vs[0].U.f.name)
+		}
+	}
+
+	return nil
+}
+
+// SortValUnits sorts the ValUnits into ascending order after converting
+// them to base units. Values of equal size keep their original order. The
+// units may differ but must all be in the same Family; if they are not a
+// non-nil error is returned and the slice is not changed.
+func SortValUnits(vs []ValUnit) error {
+	if err := checkSameFamily(vs); err != nil {
+		return err
+	}
+
+	type sortVal struct {
+		v    ValUnit
+		base float64
+	}
+
+	svs := make([]sortVal, 0, len(vs))
+
+	for _, v := range vs {
+		b, err := convertToBaseUnits(v.V, v.U)
+		if err != nil {
+			return err
+		}
+
+		svs = append(svs, sortVal{v: v, base: b})
+	}
+
+	slices.SortStableFunc(svs, func(a, b sortVal) int {
+		return cmp.Compare(a.base, b.base)
+	})
+
+	for i, sv := range svs {
+		vs[i] = sv.v
+	}
+
+	return nil
+}
+
+// DedupValUnits returns the ValUnits sorted as for SortValUnits with any
+// value which is equal to within the Tolerance of the last value kept
+// removed, so that the first of each group of almost equal values is kept.
+// The slice passed is not changed. A non-nil error is returned if the
+// units are not all in the same Family.
+func DedupValUnits(vs []ValUnit, t Tolerance) ([]ValUnit, error) {
+	rval := slices.Clone(vs)
+	if err := SortValUnits(rval); err != nil {
+		return nil, err
+	}
+
+	if len(rval) == 0 {
+		return rval, nil
+	}
+
+	kept := rval[:1]
+
+	for _, v := range rval[1:] {
+		eq, err := AlmostEqual(kept[len(kept)-1], v, t)
+		if err != nil {
+			return nil, err
+		}
+
+		if !eq {
+			kept = append(kept, v)
+		}
+	}
+
+	return kept, nil
+}
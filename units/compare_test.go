@@ -0,0 +1,209 @@
+package units
+
+import (
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+// mkVU returns a ValUnit in the named unit of the Family
+func mkVU(f *Family, v float64, uName string) ValUnit {
+	return ValUnit{V: v, U: f.GetUnitOrPanic(uName)}
+}
+
+func TestCompare(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		a, b   ValUnit
+		expCmp int
+	}{
+		{
+			ID:     testhelper.MkID("mile after km"),
+			a:      mkVU(distanceFamily, 1, "mile"),
+			b:      mkVU(distanceFamily, 1, "km"),
+			expCmp: 1,
+		},
+		{
+			ID:     testhelper.MkID("foot equals metres"),
+			a:      mkVU(distanceFamily, 1, "foot"),
+			b:      mkVU(distanceFamily, 0.3048, "metre"),
+			expCmp: 0,
+		},
+		{
+			ID:     testhelper.MkID("with offsets"),
+			a:      mkVU(temperatureFamily, 30, "F"),
+			b:      mkVU(temperatureFamily, 0, "C"),
+			expCmp: -1,
+		},
+		{
+			ID: testhelper.MkID("mismatched families"),
+			a:  mkVU(distanceFamily, 1, "metre"),
+			b:  mkVU(massFamily, 1, "kg"),
+			ExpErr: testhelper.MkExpErr(
+				"mismatched unit families. Cannot compare distance with mass"),
+		},
+		{
+			ID:     testhelper.MkID("zero ValUnit"),
+			a:      mkVU(distanceFamily, 1, "metre"),
+			ExpErr: testhelper.MkExpErr(`the unit "" has no family`),
+		},
+	}
+
+	for _, tc := range testCases {
+		c, err := Compare(tc.a, tc.b)
+		if testhelper.CheckExpErr(t, err, tc) && err == nil {
+			testhelper.DiffInt(t, tc.IDStr(), "comparison", c, tc.expCmp)
+		}
+	}
+}
+
+func TestAlmostEqual(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		a, b     ValUnit
+		tol      Tolerance
+		expEqual bool
+	}{
+		{
+			ID:       testhelper.MkID("absolute, equal"),
+			a:        mkVU(distanceFamily, 1, "mile"),
+			b:        mkVU(distanceFamily, 1609.3, "metre"),
+			tol:      AbsTolerance(mkVU(distanceFamily, 1, "foot")),
+			expEqual: true,
+		},
+		{
+			ID:  testhelper.MkID("absolute, not equal"),
+			a:   mkVU(distanceFamily, 1, "mile"),
+			b:   mkVU(distanceFamily, 1609, "metre"),
+			tol: AbsTolerance(mkVU(distanceFamily, 1, "foot")),
+		},
+		{
+			ID:       testhelper.MkID("absolute, temperature difference"),
+			a:        mkVU(temperatureFamily, 100, "C"),
+			b:        mkVU(temperatureFamily, 211, "F"),
+			tol:      AbsTolerance(mkVU(temperatureFamily, 2, "F")),
+			expEqual: true,
+		},
+		{
+			ID:       testhelper.MkID("relative, equal"),
+			a:        mkVU(massFamily, 1, "pound"),
+			b:        mkVU(massFamily, 0.45, "kg"),
+			tol:      RelTolerance(0.01),
+			expEqual: true,
+		},
+		{
+			ID:  testhelper.MkID("relative, not equal"),
+			a:   mkVU(massFamily, 1, "pound"),
+			b:   mkVU(massFamily, 0.44, "kg"),
+			tol: RelTolerance(0.01),
+		},
+		{
+			ID:       testhelper.MkID("zero tolerance"),
+			a:        mkVU(distanceFamily, 1, "foot"),
+			b:        mkVU(distanceFamily, 0.3048, "metre"),
+			expEqual: true,
+		},
+		{
+			ID:  testhelper.MkID("tolerance in the wrong family"),
+			a:   mkVU(distanceFamily, 1, "foot"),
+			b:   mkVU(distanceFamily, 0.3048, "metre"),
+			tol: AbsTolerance(mkVU(massFamily, 1, "kg")),
+			ExpErr: testhelper.MkExpErr(
+				"mismatched unit families. The tolerance is in mass not distance"),
+		},
+	}
+
+	for _, tc := range testCases {
+		eq, err := AlmostEqual(tc.a, tc.b, tc.tol)
+		if testhelper.CheckExpErr(t, err, tc) && err == nil {
+			testhelper.DiffBool(t, tc.IDStr(), "almost equal", eq, tc.expEqual)
+		}
+	}
+}
+
+// valUnitStrings returns the ValUnits as strings
+func valUnitStrings(vs []ValUnit) []string {
+	rval := make([]string, 0, len(vs))
+	for _, v := range vs {
+		rval = append(rval, v.String())
+	}
+
+	return rval
+}
+
+func TestSortAndDedupValUnits(t *testing.T) {
+	vs := []ValUnit{
+		mkVU(distanceFamily, 1, "mile"),
+		mkVU(distanceFamily, 1, "km"),
+		mkVU(distanceFamily, 1, "foot"),
+		mkVU(distanceFamily, 1, "yard"),
+		mkVU(distanceFamily, 0.9144, "metre"),
+		mkVU(distanceFamily, 1000.5, "metre"),
+	}
+
+	deduped, err := DedupValUnits(vs,
+		AbsTolerance(mkVU(distanceFamily, 0.6, "metre")))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	testhelper.DiffStringSlice(t, "dedup", "values",
+		valUnitStrings(deduped),
+		[]string{"1 foot", "1 yard", "1 kilometre", "1 mile"})
+	testhelper.DiffString(t, "dedup", "original first value",
+		vs[0].String(), "1 mile")
+
+	chain := []ValUnit{
+		mkVU(distanceFamily, 0, "metre"),
+		mkVU(distanceFamily, 0.9, "metre"),
+		mkVU(distanceFamily, 1.8, "metre"),
+		mkVU(distanceFamily, 2.7, "metre"),
+		mkVU(distanceFamily, 3.6, "metre"),
+	}
+
+	deduped, err = DedupValUnits(chain,
+		AbsTolerance(mkVU(distanceFamily, 1, "metre")))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	testhelper.DiffStringSlice(t, "dedup, chained", "values",
+		valUnitStrings(deduped),
+		[]string{"0 metres", "1.8 metres", "3.6 metres"})
+
+	if err := SortValUnits(vs); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	testhelper.DiffStringSlice(t, "sort", "values",
+		valUnitStrings(vs),
+		[]string{
+			"1 foot", "1 yard", "0.9144 metres",
+			"1 kilometre", "1000.5 metres", "1 mile",
+		})
+
+	mixed := []ValUnit{
+		mkVU(distanceFamily, 1, "metre"),
+		mkVU(massFamily, 1, "kg"),
+	}
+	err = SortValUnits(mixed)
+	testhelper.CheckExpErr(t, err, struct {
+		testhelper.ID
+		testhelper.ExpErr
+	}{
+		ID: testhelper.MkID("mixed families"),
+		ExpErr: testhelper.MkExpErr(
+			"mismatched unit families. Value 1 is in mass not distance"),
+	})
+
+	err = SortValUnits([]ValUnit{mkVU(distanceFamily, 1, "metre"), {}})
+	testhelper.CheckExpErr(t, err, struct {
+		testhelper.ID
+		testhelper.ExpErr
+	}{
+		ID:     testhelper.MkID("zero ValUnit"),
+		ExpErr: testhelper.MkExpErr(`value 1: the unit "" has no family`),
+	})
+}
@@ -0,0 +1,109 @@
+package units
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Converter converts values from one Unit to another. The conversion into
+// the base units and the conversion out of them are combined when the
+// Converter is created so that each value is converted with a single
+// multiply-add. This makes it much cheaper than calling ValUnit.Convert
+// when many values are to be converted between the same pair of Units.
+//
+// The results may differ from those given by ValUnit.Convert in the last
+// few bits due to the different order of the floating point operations.
+type Converter struct {
+	from   Unit
+	to     Unit
+	scale  float64
+	offset float64
+}
+
+// NewConverter returns a Converter which will convert values from the
+// 'from' Unit to the 'to' Unit. A non-nil error is returned if the Units
+// are not in the same Family or are otherwise invalid (have a zero
+// ConvFactor).
+func NewConverter(from, to Unit) (*Converter, error) {
+	if from.f == nil || to.f == nil {
+		return nil, errors.New("bad units - the unit family is not set")
+	}
+
+	if from.f != to.f {
+		return nil,
+			fmt.Errorf(
+				"mismatched unit families. Cannot convert units from %s to %s",
+				from.f.name, to.f.name)
+	}
+
+	if from.convFactor == 0 || to.convFactor == 0 {
+		return nil, errors.New("bad units - a zero conversion factor")
+	}
+
+	// The conversion via the base units is:
+	//
+	//	b = ((v - from.convPostAdd) * from.convFactor) - from.convPreAdd
+	//	r = ((b + to.convPreAdd) / to.convFactor) + to.convPostAdd
+	//
+	// which can be rearranged as r = v*scale + offset
+	return &Converter{
+		from:  from,
+		to:    to,
+		scale: from.convFactor / to.convFactor,
+		offset: (to.convPreAdd-from.convPreAdd-
+			from.convPostAdd*from.convFactor)/to.convFactor +
+			to.convPostAdd,
+	}, nil
+}
+
+// NewConverterOrPanic will call NewConverter and if the error returned is
+// not nil it will panic, otherwise it will return the Converter
+func NewConverterOrPanic(from, to Unit) *Converter {
+	c, err := NewConverter(from, to)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// From returns the Unit that values are converted from
+func (c *Converter) From() Unit {
+	return c.from
+}
+
+// To returns the Unit that values are converted to
+func (c *Converter) To() Unit {
+	return c.to
+}
+
+// Convert returns the value, expressed in the 'from' Unit, converted into
+// the 'to' Unit
+func (c *Converter) Convert(v float64) float64 {
+	return v*c.scale + c.offset
+}
+
+// ConvertValUnit converts the ValUnit into the 'to' Unit. A non-nil error
+// is returned if the ValUnit is not in the 'from' Unit.
+func (c *Converter) ConvertValUnit(vu ValUnit) (ValUnit, error) {
+	if vu.U.f != c.from.f || vu.U.id != c.from.id {
+		return ValUnit{U: c.to},
+			fmt.Errorf("the value is in %s not %s",
+				vu.U.namePlural, c.from.namePlural)
+	}
+
+	return ValUnit{V: c.Convert(vu.V), U: c.to}, nil
+}
+
+// ConvertSlice converts each of the values, expressed in the 'from' Unit,
+// into the 'to' Unit. The values are converted in place so that no memory
+// is allocated; the slice is returned for convenience.
+func (c *Converter) ConvertSlice(vals []float64) []float64 {
+	scale, offset := c.scale, c.offset
+
+	for i, v := range vals {
+		vals[i] = v*scale + offset
+	}
+
+	return vals
+}
@@ -0,0 +1,183 @@
+package units
+
+import (
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestConverter(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		f        *Family
+		from, to string
+		vals     []float64
+	}{
+		{
+			ID:   testhelper.MkID("distance"),
+			f:    distanceFamily,
+			from: "foot",
+			to:   "kilometre",
+			vals: []float64{0, 1, -2.5, 5280, 1e9},
+		},
+		{
+			ID:   testhelper.MkID("temperature"),
+			f:    temperatureFamily,
+			from: "F",
+			to:   "K",
+			vals: []float64{-459.67, 0, 32, 98.6, 212},
+		},
+		{
+			ID:   testhelper.MkID("temperature - reverse"),
+			f:    temperatureFamily,
+			from: "K",
+			to:   "F",
+			vals: []float64{0, 273.15, 373.15},
+		},
+		{
+			ID:   testhelper.MkID("same unit"),
+			f:    dataFamily,
+			from: "GiB",
+			to:   "GiB",
+			vals: []float64{0, 1, 1.5},
+		},
+	}
+
+	for _, tc := range testCases {
+		from := tc.f.GetUnitOrPanic(tc.from)
+		to := tc.f.GetUnitOrPanic(tc.to)
+		c := NewConverterOrPanic(from, to)
+
+		slice := append([]float64(nil), tc.vals...)
+		c.ConvertSlice(slice)
+
+		for i, v := range tc.vals {
+			exp := ValUnit{V: v, U: from}.ConvertOrPanic(to).V
+			eps := 1e-12 * max(1, exp, -exp)
+
+			testhelper.DiffFloat(t, tc.IDStr(), "Convert",
+				c.Convert(v), exp, eps)
+			testhelper.DiffFloat(t, tc.IDStr(), "ConvertSlice",
+				slice[i], exp, eps)
+
+			vu, err := c.ConvertValUnit(ValUnit{V: v, U: from})
+			if err != nil {
+				t.Fatal(tc.IDStr(), ": unexpected error: ", err)
+			}
+
+			testhelper.DiffFloat(t, tc.IDStr(), "ConvertValUnit",
+				vu.V, exp, eps)
+			testhelper.DiffString(t, tc.IDStr(), "ConvertValUnit unit",
+				vu.U.ID(), to.ID())
+		}
+	}
+}
+
+func TestNewConverter(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		from, to Unit
+	}{
+		{
+			ID:   testhelper.MkID("good"),
+			from: distanceFamily.GetUnitOrPanic("foot"),
+			to:   distanceFamily.GetUnitOrPanic("metre"),
+		},
+		{
+			ID:   testhelper.MkID("mismatched families"),
+			from: distanceFamily.GetUnitOrPanic("foot"),
+			to:   massFamily.GetUnitOrPanic("kg"),
+			ExpErr: testhelper.MkExpErr(
+				"mismatched unit families." +
+					" Cannot convert units from distance to mass"),
+		},
+		{
+			ID:   testhelper.MkID("no family"),
+			from: Unit{},
+			to:   massFamily.GetUnitOrPanic("kg"),
+			ExpErr: testhelper.MkExpErr(
+				"bad units - the unit family is not set"),
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := NewConverter(tc.from, tc.to)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
+
+func TestConverterConvertValUnit(t *testing.T) {
+	c := NewConverterOrPanic(
+		distanceFamily.GetUnitOrPanic("foot"),
+		distanceFamily.GetUnitOrPanic("metre"))
+
+	_, err := c.ConvertValUnit(mkVU(distanceFamily, 1, "inch"))
+	testhelper.CheckExpErr(t, err,
+		struct {
+			testhelper.ID
+			testhelper.ExpErr
+		}{
+			ID:     testhelper.MkID("wrong unit"),
+			ExpErr: testhelper.MkExpErr("the value is in inches not feet"),
+		})
+}
+
+// benchVals returns a slice of values to convert in the benchmarks
+func benchVals() []float64 {
+	const n = 1024
+
+	vals := make([]float64, n)
+	for i := range vals {
+		vals[i] = float64(i) * 0.5
+	}
+
+	return vals
+}
+
+// convSink receives the results of the benchmarks so that the conversions
+// are not optimised away
+var convSink float64
+
+func BenchmarkValUnitConvert(b *testing.B) {
+	from := temperatureFamily.GetUnitOrPanic("F")
+	to := temperatureFamily.GetUnitOrPanic("C")
+	vals := benchVals()
+
+	for b.Loop() {
+		for _, v := range vals {
+			vu, _ := ValUnit{V: v, U: from}.Convert(to)
+			convSink = vu.V
+		}
+	}
+}
+
+func BenchmarkConverterConvert(b *testing.B) {
+	c := NewConverterOrPanic(
+		temperatureFamily.GetUnitOrPanic("F"),
+		temperatureFamily.GetUnitOrPanic("C"))
+	vals := benchVals()
+
+	for b.Loop() {
+		for _, v := range vals {
+			convSink = c.Convert(v)
+		}
+	}
+}
+
+func BenchmarkConverterConvertSlice(b *testing.B) {
+	c := NewConverterOrPanic(
+		temperatureFamily.GetUnitOrPanic("F"),
+		temperatureFamily.GetUnitOrPanic("C"))
+	vals := benchVals()
+	scratch := make([]float64, len(vals))
+
+	// The slice is converted in place so the values are copied afresh each
+	// time to stop them drifting
+	for b.Loop() {
+		copy(scratch, vals)
+		c.ConvertSlice(scratch)
+	}
+
+	convSink = scratch[0]
+}
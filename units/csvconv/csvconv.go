@@ -0,0 +1,412 @@
+package csvconv
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/nickwells/units.mod/v2/units"
+)
+
+// unitColSuffix is added to the name of a converted column to give the
+// name of the unit column when the value and unit are written separately
+const unitColSuffix = "_unit"
+
+// outputSigFigs is the number of significant figures in the converted
+// values. This is as many as a float64 reliably holds and hides the
+// rounding errors introduced by the conversion.
+const outputSigFigs = 15
+
+// column records a column to be converted and the target unit
+type column struct {
+	name   string
+	index  int
+	target units.Unit
+}
+
+// Converter converts the quantities in selected columns of CSV or TSV data
+// to a target unit. It should be created with New.
+type Converter struct {
+	comma     rune
+	noHeader  bool
+	splitUnit bool
+	onError   func(RowError)
+	cols      []column
+}
+
+// Opt is the type of an option that can be passed to New
+type Opt func(*Converter) error
+
+// OptComma returns an Opt which sets the field separator. The default is a
+// comma.
+func OptComma(r rune) Opt {
+	return func(c *Converter) error {
+		if r == '"' || r == '\r' || r == '\n' {
+			return fmt.Errorf("%q cannot be used as the field separator", r)
+		}
+
+		c.comma = r
+
+		return nil
+	}
+}
+
+// OptTSV returns an Opt which causes the fields to be separated by tabs
+func OptTSV() Opt {
+	return OptComma('\t')
+}
+
+// OptNoHeader returns an Opt which indicates that the data has no header
+// row. Columns must then be selected by index (see OptColumnIndex).
+func OptNoHeader() Opt {
+	return func(c *Converter) error {
+		c.noHeader = true
+		return nil
+	}
+}
+
+// OptSplitUnit returns an Opt which causes the converted value and the
+// unit to be written in separate columns. The unit column follows the
+// value column and, if there is a header, is named after it with "_unit"
+// appended.
+func OptSplitUnit() Opt {
+	return func(c *Converter) error {
+		c.splitUnit = true
+		return nil
+	}
+}
+
+// OptErrorHandler returns an Opt which sets the function called for each
+// value which cannot be converted
+func OptErrorHandler(h func(RowError)) Opt {
+	return func(c *Converter) error {
+		c.onError = h
+		return nil
+	}
+}
+
+// OptColumn returns an Opt which selects the column with the given name in
+// the header row for conversion to the target Unit
+func OptColumn(name string, target units.Unit) Opt {
+	return func(c *Converter) error {
+		if name == "" {
+			return errors.New("the column name must not be empty")
+		}
+
+		c.cols = append(c.cols, column{name: name, index: -1, target: target})
+
+		return nil
+	}
+}
+
+// OptColumnIndex returns an Opt which selects the column with the given
+// index, counting from zero, for conversion to the target Unit
+func OptColumnIndex(idx int, target units.Unit) Opt {
+	return func(c *Converter) error {
+		if idx < 0 {
+			return fmt.Errorf("the column index (%d) must not be negative", idx)
+		}
+
+		c.cols = append(c.cols, column{index: idx, target: target})
+
+		return nil
+	}
+}
+
+// New returns a Converter configured by the options. At least one column
+// must be selected. A non-nil error is returned if any option is invalid.
+func New(opts ...Opt) (*Converter, error) {
+	c := &Converter{comma: ','}
+
+	for _, o := range opts {
+		if err := o(c); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(c.cols) == 0 {
+		return nil, errors.New("no columns have been selected for conversion")
+	}
+
+	for _, col := range c.cols {
+		if col.target.Family() == nil {
+			return nil, fmt.Errorf("the target unit for %s is invalid",
+				col.description())
+		}
+
+		if col.name != "" && c.noHeader {
+			return nil, fmt.Errorf(
+				"%s cannot be selected by name as there is no header",
+				col.description())
+		}
+	}
+
+	return c, nil
+}
+
+// description returns a description of the column for use in messages
+func (col column) description() string {
+	if col.name != "" {
+		return fmt.Sprintf("column %q", col.name)
+	}
+
+	return fmt.Sprintf("column %d", col.index)
+}
+
+// RowError records a value which could not be converted
+type RowError struct {
+	Row    int    // the number of the record, counting the header as 1
+	Column string // the name, or index, of the column, if known
+	Value  string // the value which could not be converted
+	Err    error
+}
+
+// Error returns a string describing the RowError
+func (e RowError) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("row %d: %s", e.Row, e.Err)
+	}
+
+	return fmt.Sprintf("row %d, column %s: %q: %s",
+		e.Row, e.Column, e.Value, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e RowError) Unwrap() error {
+	return e.Err
+}
+
+// Summary records the outcome of a conversion
+type Summary struct {
+	Rows      int // the number of records read, excluding any header
+	Converted int // the number of values converted
+	Errors    int // the number of values or records which were not converted
+}
+
+// resolveColumns finds the index of each column selected by name in the
+// header and checks that no column is selected twice
+func (c *Converter) resolveColumns(header []string) ([]column, error) {
+	cols := make([]column, 0, len(c.cols))
+	seen := map[int]bool{}
+
+	for _, col := range c.cols {
+		if col.name != "" {
+			col.index = -1
+
+			for i, h := range header {
+				if strings.TrimSpace(h) == col.name {
+					col.index = i
+					break
+				}
+			}
+
+			if col.index < 0 {
+				return nil,
+					fmt.Errorf("there is no column called %q in the header",
+						col.name)
+			}
+		} else {
+			col.name = strconv.Itoa(col.index)
+			if header != nil && col.index < len(header) {
+				col.name = header[col.index]
+			}
+		}
+
+		if seen[col.index] {
+			return nil, fmt.Errorf("column %q is selected more than once",
+				col.name)
+		}
+
+		seen[col.index] = true
+		cols = append(cols, col)
+	}
+
+	return cols, nil
+}
+
+// outputHeader returns the header to write, with the unit columns added if
+// the value and unit are to be written separately
+func (c *Converter) outputHeader(header []string,
+	byIndex map[int]column,
+) []string {
+	if !c.splitUnit {
+		return header
+	}
+
+	out := make([]string, 0, len(header)+len(byIndex))
+	for i, h := range header {
+		out = append(out, h)
+		if _, ok := byIndex[i]; ok {
+			out = append(out, h+unitColSuffix)
+		}
+	}
+
+	return out
+}
+
+// convertValue converts the value to the target unit of the column
+func convertValue(s string, col column) (units.ValUnit, error) {
+	pvu, err := col.target.Family().ParseValUnit(s)
+	if err != nil {
+		return units.ValUnit{}, err
+	}
+
+	return pvu.ValUnit.Convert(col.target)
+}
+
+// convertRecord returns the output record for the input record, adding
+// the unit columns if required. Any values which cannot be converted are
+// reported.
+func (c *Converter) convertRecord(rec []string, row int,
+	cols []column, byIndex map[int]column, sum *Summary, out []string,
+) []string {
+	out = out[:0]
+
+	for i, cell := range rec {
+		col, ok := byIndex[i]
+		if !ok {
+			out = append(out, cell)
+			continue
+		}
+
+		val, unit := cell, ""
+
+		if strings.TrimSpace(cell) != "" {
+			vu, err := convertValue(cell, col)
+			if err != nil {
+				sum.Errors++
+				c.report(RowError{
+					Row: row, Column: col.name, Value: cell, Err: err,
+				})
+			} else {
+				sum.Converted++
+				val = strconv.FormatFloat(vu.V, 'g', outputSigFigs, 64)
+				unit = col.target.Abbrev()
+
+				if !c.splitUnit {
+					val += " " + unit
+				}
+			}
+		}
+
+		out = append(out, val)
+		if c.splitUnit {
+			out = append(out, unit)
+		}
+	}
+
+	for _, col := range cols {
+		if col.index >= len(rec) {
+			sum.Errors++
+			c.report(RowError{
+				Row: row, Column: col.name,
+				Err: errors.New("the record has no such column"),
+			})
+		}
+	}
+
+	return out
+}
+
+// report passes the RowError to the error handler, if there is one
+func (c *Converter) report(e RowError) {
+	if c.onError != nil {
+		c.onError(e)
+	}
+}
+
+// Convert reads CSV (or TSV) records from the Reader, converts the values
+// in the selected columns and writes the records to the Writer. The
+// records are processed one at a time so the memory used does not depend
+// on the size of the data.
+//
+// Values which cannot be converted are written unchanged and reported to
+// the error handler (see OptErrorHandler); they do not stop the
+// conversion. Empty values are written unchanged and are not reported.
+// Records which cannot be parsed, for instance because of a stray quote,
+// are reported and left out of the output. A non-nil error is returned if
+// the header cannot be parsed, the data cannot be read or written or a
+// selected column is not in the header.
+func (c *Converter) Convert(r io.Reader, w io.Writer) (Summary, error) {
+	var sum Summary
+
+	cr := csv.NewReader(r)
+	cr.Comma = c.comma
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+
+	cw := csv.NewWriter(w)
+	cw.Comma = c.comma
+
+	var header []string
+
+	row := 0
+
+	if !c.noHeader {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			return sum, nil
+		}
+
+		if err != nil {
+			return sum, err
+		}
+
+		row++
+
+		header = append([]string(nil), rec...)
+	}
+
+	cols, err := c.resolveColumns(header)
+	if err != nil {
+		return sum, err
+	}
+
+	byIndex := make(map[int]column, len(cols))
+	for _, col := range cols {
+		byIndex[col.index] = col
+	}
+
+	if header != nil {
+		if err := cw.Write(c.outputHeader(header, byIndex)); err != nil {
+			return sum, err
+		}
+	}
+
+	var out []string
+
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+
+		row++
+		sum.Rows++
+
+		var pe *csv.ParseError
+		if errors.As(err, &pe) {
+			sum.Errors++
+			c.report(RowError{Row: row, Err: pe})
+
+			continue
+		}
+
+		if err != nil {
+			cw.Flush()
+			return sum, err
+		}
+
+		out = c.convertRecord(rec, row, cols, byIndex, &sum, out)
+		if err := cw.Write(out); err != nil {
+			return sum, err
+		}
+	}
+
+	cw.Flush()
+
+	return sum, cw.Error()
+}
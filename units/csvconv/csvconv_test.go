@@ -0,0 +1,173 @@
+package csvconv
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+	"github.com/nickwells/units.mod/v2/units"
+)
+
+func TestConvert(t *testing.T) {
+	kg := units.GetOrPanic("mass", "kg")
+	metre := units.GetOrPanic("distance", "metre")
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		opts       []Opt
+		in         string
+		expOut     string
+		expSummary Summary
+		expRowErrs []string
+	}{
+		{
+			ID:   testhelper.MkID("by name"),
+			opts: []Opt{OptColumn("weight", kg)},
+			in: "id,weight\n" +
+				"1,10 lb\n" +
+				"2,5.5 kg\n" +
+				"3,\n",
+			expOut: "id,weight\n" +
+				"1,4.5359237 kg\n" +
+				"2,5.5 kg\n" +
+				"3,\n",
+			expSummary: Summary{Rows: 3, Converted: 2},
+		},
+		{
+			ID: testhelper.MkID("TSV, split unit, bad values"),
+			opts: []Opt{
+				OptTSV(),
+				OptSplitUnit(),
+				OptColumn("weight", kg),
+			},
+			in: "id\tweight\tnote\n" +
+				"1\t2000 g\tok\n" +
+				"2\theavy\tbad\n" +
+				"3\t3 furlongs\tbad\n",
+			expOut: "id\tweight\tweight_unit\tnote\n" +
+				"1\t2\tkg\tok\n" +
+				"2\theavy\t\tbad\n" +
+				"3\t3 furlongs\t\tbad\n",
+			expSummary: Summary{Rows: 3, Converted: 1, Errors: 2},
+			expRowErrs: []string{
+				`row 3, column weight: "heavy":` +
+					` "heavy" does not start with a number`,
+				`row 4, column weight: "3 furlongs":` +
+					` there is no unit of mass called "furlongs"`,
+			},
+		},
+		{
+			ID: testhelper.MkID("no header, by index, short row"),
+			opts: []Opt{
+				OptNoHeader(),
+				OptColumnIndex(1, metre),
+				OptColumnIndex(2, kg),
+			},
+			in: "a,3 ft,1 kg\n" +
+				"b,1 km\n",
+			expOut: "a,0.9144 m,1 kg\n" +
+				"b,1000 m\n",
+			expSummary: Summary{Rows: 2, Converted: 3, Errors: 1},
+			expRowErrs: []string{
+				`row 2, column 2: "": the record has no such column`,
+			},
+		},
+		{
+			ID:   testhelper.MkID("bad quote"),
+			opts: []Opt{OptColumn("weight", kg)},
+			in: "id,weight\n" +
+				"1,2 kg\n" +
+				"2,3 \"kg\n" +
+				"3,1000 g\n",
+			expOut: "id,weight\n" +
+				"1,2 kg\n" +
+				"3,1 kg\n",
+			expSummary: Summary{Rows: 3, Converted: 2, Errors: 1},
+			expRowErrs: []string{
+				`row 3: parse error on line 3, column 5:` +
+					` bare " in non-quoted-field`,
+			},
+		},
+		{
+			ID:   testhelper.MkID("missing column"),
+			opts: []Opt{OptColumn("mass", kg)},
+			in:   "id,weight\n1,2 kg\n",
+			ExpErr: testhelper.MkExpErr(
+				`there is no column called "mass" in the header`),
+		},
+	}
+
+	for _, tc := range testCases {
+		var rowErrs []string
+
+		opts := append(tc.opts, OptErrorHandler(func(e RowError) {
+			rowErrs = append(rowErrs, e.Error())
+		}))
+
+		c, err := New(opts...)
+		if err != nil {
+			t.Fatal(tc.IDStr(), ": unexpected error: ", err)
+		}
+
+		var out strings.Builder
+
+		sum, err := c.Convert(strings.NewReader(tc.in), &out)
+		if testhelper.CheckExpErr(t, err, tc) && err == nil {
+			testhelper.DiffString(t, tc.IDStr(), "output",
+				out.String(), tc.expOut)
+			testhelper.DiffInt(t, tc.IDStr(), "rows",
+				sum.Rows, tc.expSummary.Rows)
+			testhelper.DiffInt(t, tc.IDStr(), "converted",
+				sum.Converted, tc.expSummary.Converted)
+			testhelper.DiffInt(t, tc.IDStr(), "errors",
+				sum.Errors, tc.expSummary.Errors)
+			testhelper.DiffStringSlice(t, tc.IDStr(), "row errors",
+				rowErrs, tc.expRowErrs)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	kg := units.GetOrPanic("mass", "kg")
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		opts []Opt
+	}{
+		{
+			ID:   testhelper.MkID("good"),
+			opts: []Opt{OptColumn("weight", kg)},
+		},
+		{
+			ID: testhelper.MkID("no columns"),
+			ExpErr: testhelper.MkExpErr(
+				"no columns have been selected for conversion"),
+		},
+		{
+			ID:   testhelper.MkID("name without a header"),
+			opts: []Opt{OptNoHeader(), OptColumn("weight", kg)},
+			ExpErr: testhelper.MkExpErr(
+				`column "weight" cannot be selected by name` +
+					` as there is no header`),
+		},
+		{
+			ID:   testhelper.MkID("bad target unit"),
+			opts: []Opt{OptColumnIndex(0, units.Unit{})},
+			ExpErr: testhelper.MkExpErr(
+				"the target unit for column 0 is invalid"),
+		},
+		{
+			ID:   testhelper.MkID("bad separator"),
+			opts: []Opt{OptComma('"'), OptColumn("weight", kg)},
+			ExpErr: testhelper.MkExpErr(
+				`'"' cannot be used as the field separator`),
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := New(tc.opts...)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
@@ -0,0 +1,18 @@
+/*
+Package csvconv converts quantities held in columns of CSV or TSV data to a
+single unit. For instance a "weight" column holding a mix of values such as
+"12 lb" and "5.2 kg" can be normalised so that every value is in kilograms.
+
+A Converter is created with New, passing options to select the columns to
+convert and the target unit for each. Its Convert method reads records from
+an io.Reader and writes them to an io.Writer one at a time so that files of
+any size can be converted in bounded memory. The converted value may be
+written as a single string ("5.44 kg") or as separate value and unit
+columns.
+
+A value which cannot be parsed or converted does not stop the conversion;
+the original value is written unchanged and the problem is reported as a
+RowError to the error handler, if one is given, and counted in the returned
+Summary.
+*/
+package csvconv
@@ -270,3 +270,64 @@ var dataNames = map[string]Unit{
 		"", "",
 	},
 }
+
+// dataProvenance records the source of the conversion factor for each unit
+// of data
+var dataProvenance = map[string]Provenance{
+	bunData:  provISO80000,
+	"bit":    provISO80000,
+	"nibble": provCommonExact,
+	"KB":     provISO80000,
+	"MB":     provISO80000,
+	"GB":     provISO80000,
+	"TB":     provISO80000,
+	"PB":     provISO80000,
+	"EB":     provISO80000,
+	"ZB":     provISO80000,
+	"YB":     provISO80000,
+	"KiB":    provISO80000,
+	"MiB":    provISO80000,
+	"GiB":    provISO80000,
+	"TiB":    provISO80000,
+	"PiB":    provISO80000,
+	"EiB":    provISO80000,
+	"ZiB":    provISO80000,
+	"YiB":    provISO80000,
+}
+
+// dataUCUM records the UCUM codes of those units of data which have one
+var dataUCUM = map[string]string{
+	"bit":   "bit",
+	bunData: "By",
+	"KB":    "kBy",
+	"MB":    "MBy",
+	"GB":    "GBy",
+	"TB":    "TBy",
+	"PB":    "PBy",
+	"EB":    "EBy",
+	"ZB":    "ZBy",
+	"YB":    "YBy",
+	"KiB":   "KiBy",
+	"MiB":   "MiBy",
+	"GiB":   "GiBy",
+	"TiB":   "TiBy",
+}
+
+// dataRec20 records the UN/ECE Recommendation 20 codes of those units of data
+// which have one
+var dataRec20 = map[string]string{
+	"bit":   "A99",
+	bunData: "AD",
+	"KB":    "2P",
+	"MB":    "4L",
+	"GB":    "E34",
+	"TB":    "E35",
+	"PB":    "E36",
+}
+
+// dataExternalIDs records the identifiers of the units of data in external
+// schemes (see Unit.ExternalIDs)
+var dataExternalIDs = map[string]map[string]string{
+	"bit":   {SchemeQUDT: "BIT", SchemeWikidata: "Q8805", SchemeOM: "bit"},
+	bunData: {SchemeQUDT: "BYTE", SchemeWikidata: "Q8799", SchemeOM: "byte"},
+}
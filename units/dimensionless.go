@@ -413,3 +413,70 @@ var dimensionlessNames = map[string]Unit{
 		"", "",
 	},
 }
+
+// dimensionlessProvenance records the source of the conversion factor for
+// each dimensionless unit
+var dimensionlessProvenance = map[string]Provenance{
+	bunNumeric: provSI,
+	"y":        provSI,
+	"z":        provSI,
+	"a":        provSI,
+	"f":        provSI,
+	"p":        provSI,
+	"n":        provSI,
+	"u":        provSI,
+	"m":        provSI,
+	"c":        provSI,
+	"d":        provSI,
+	"da":       provSI,
+	"h":        provSI,
+	"k":        provSI,
+	"M":        provSI,
+	"G":        provSI,
+	"T":        provSI,
+	"P":        provSI,
+	"E":        provSI,
+	"Z":        provSI,
+	"Y":        provSI,
+
+	"dozen":        provCommonExact,
+	"bakers dozen": provCommonExact,
+	"score":        provCommonExact,
+	"myriad":       provCommonExact,
+
+	"million":       provCommonExact,
+	"billion":       provCommonExact,
+	"trillion":      provCommonExact,
+	"quadrillion":   provCommonExact,
+	"milliard":      provCommonExact,
+	"billion (UK)":  provCommonExact,
+	"billiard":      provCommonExact,
+	"trillion (UK)": provCommonExact,
+	"trilliard":     provCommonExact,
+	"lakh":          provCommonExact,
+	"crore":         provCommonExact,
+
+	"pony":   provColloquial,
+	"monkey": provColloquial,
+	"grand":  provColloquial,
+
+	"Avogadro number": provSI,
+}
+
+// dimensionlessSymbols records the typographic symbols of those
+// dimensionless units whose symbols differ from their abbreviations
+var dimensionlessSymbols = map[string]string{
+	"u": "µ",
+}
+
+// dimensionlessUCUM records the UCUM codes of those dimensionless units
+// which have one
+var dimensionlessUCUM = map[string]string{
+	bunNumeric: "1",
+}
+
+// dimensionlessExternalIDs records the identifiers of the dimensionless
+// units in external schemes (see Unit.ExternalIDs)
+var dimensionlessExternalIDs = map[string]map[string]string{
+	bunNumeric: {SchemeQUDT: "UNITLESS", SchemeOM: "one"},
+}
@@ -296,7 +296,12 @@ var distanceNames = map[string]Unit{
 		"an imperial measure of length, 12 inches.",
 		[]Tag{TagImperial, TagUScustomary},
 		map[string]string{
-			"feet":               "plural",
+			"feet": "plural",
+			// "ft" is also the abbreviation of the US and Indian survey
+			// feet and so, without this alias, it would be ambiguous and
+			// would not be found by the normalised lookup. Almost all
+			// uses mean the international foot.
+			"ft":                 "abbreviation",
 			"international foot": "alternative",
 			"statute foot":       "alternative",
 		},
@@ -914,3 +919,211 @@ var distanceNames = map[string]Unit{
 		"", "",
 	},
 }
+
+// distanceProvenance records the source of the conversion factor for each
+// unit of distance
+var distanceProvenance = map[string]Provenance{
+	bunDistance: provSI,
+	"ym":        provSI,
+	"zm":        provSI,
+	"am":        provSI,
+	"fm":        provSI,
+	"pm":        provSI,
+	"nm":        provSI,
+	"um":        provSI,
+	"mm":        provSI,
+	"cm":        provSI,
+	"dm":        provSI,
+	"dam":       provSI,
+	"hm":        provSI,
+	"km":        provSI,
+	"mym":       provFrenchMetric,
+	"Mm":        provSI,
+	"Gm":        provSI,
+	"Tm":        provSI,
+	"Pm":        provSI,
+	"Em":        provSI,
+	"Zm":        provSI,
+	"Ym":        provSI,
+
+	"barleycorn": provIntlYard,
+	"inch":       provIntlYard,
+	"hand":       provIntlYard,
+	"link":       provIntlYard,
+	"ell":        provIntlYard,
+	"foot":       provIntlYard,
+	"foot (North German)": {
+		Kind: ConvConventional, Source: SrcHistorical, SigDigits: 5,
+	},
+	"foot (Roman)": {
+		Kind: ConvMeasured, Source: SrcHistorical, SigDigits: 3,
+	},
+	"foot (Parisian)": {
+		Kind: ConvMeasured, Source: SrcHistorical, SigDigits: 3,
+	},
+	"foot (Amsterdam)": {
+		Kind: ConvMeasured, Source: SrcHistorical, SigDigits: 6,
+	},
+	"foot (Rijnland)": {
+		Kind: ConvMeasured, Source: SrcHistorical, SigDigits: 3,
+	},
+	"foot (metric)": provColloquial,
+	"US survey foot": {
+		Kind: ConvExact, Source: SrcUSMendenhall,
+	},
+	"Indian survey foot": {
+		Kind: ConvConventional, Source: SrcHistorical, SigDigits: 7,
+	},
+	"yard":          provIntlYard,
+	"rod":           provIntlYard,
+	"chain":         provIntlYard,
+	"furlong":       provIntlYard,
+	"mile":          provIntlYard,
+	"metric-mile":   provColloquial,
+	"swimming-mile": provColloquial,
+	"league":        provIntlYard,
+
+	"nautical league":              provIHC1929,
+	"fathom":                       provIntlYard,
+	"cable":                        provIHC1929,
+	"nautical-mile":                provIHC1929,
+	"nautical-mile (US)":           provHistorical,
+	"nautical-mile (Admiralty/UK)": provHistorical,
+	"admiralty-fathom":             provHistorical,
+
+	"scots mile": provHistorical,
+	"irish mile": provHistorical,
+
+	"astro-unit": {Kind: ConvExact, Source: SrcIAU2012},
+	"parsec":     {Kind: ConvExact, Source: SrcIAU2015},
+	"kiloparsec": {Kind: ConvExact, Source: SrcIAU2015},
+	"megaparsec": {Kind: ConvExact, Source: SrcIAU2015},
+	"gigaparsec": {Kind: ConvExact, Source: SrcIAU2015},
+	"light-year": {Kind: ConvExact, Source: SrcIAU2015},
+
+	"light-second": provSI,
+	"phoot":        provCommonExact,
+
+	"point": {Kind: ConvConventional, Source: SrcCommonUsage, SigDigits: 4},
+	"pica":  {Kind: ConvDerived, Source: SrcCommonUsage, SigDigits: 4},
+
+	"20ft shipping container": {Kind: ConvExact, Source: SrcISO668},
+	"bus": {
+		Kind: ConvConventional, Source: SrcCommonUsage, SigDigits: 3,
+	},
+	"Eiffel Tower": {
+		Kind: ConvConventional, Source: SrcCommonUsage, SigDigits: 1,
+	},
+	"smoot":    provCommonExact,
+	"marathon": {Kind: ConvExact, Source: SrcWorldAthletics},
+}
+
+// distanceUCUM records the UCUM codes of those units of distance which have
+// one
+var distanceUCUM = map[string]string{
+	"ym":        "ym",
+	"zm":        "zm",
+	"am":        "am",
+	"fm":        "fm",
+	"pm":        "pm",
+	"nm":        "nm",
+	"um":        "um",
+	"mm":        "mm",
+	"cm":        "cm",
+	"dm":        "dm",
+	bunDistance: "m",
+	"dam":       "dam",
+	"hm":        "hm",
+	"km":        "km",
+	"Mm":        "Mm",
+	"Gm":        "Gm",
+	"Tm":        "Tm",
+	"Pm":        "Pm",
+	"Em":        "Em",
+	"Zm":        "Zm",
+	"Ym":        "Ym",
+
+	"point":                        "[pnt]",
+	"pica":                         "[pca]",
+	"inch":                         "[in_i]",
+	"hand":                         "[hd_i]",
+	"foot":                         "[ft_i]",
+	"US survey foot":               "[ft_us]",
+	"yard":                         "[yd_i]",
+	"fathom":                       "[fth_i]",
+	"mile":                         "[mi_i]",
+	"nautical-mile":                "[nmi_i]",
+	"nautical-mile (Admiralty/UK)": "[nmi_br]",
+	"astro-unit":                   "AU",
+	"light-year":                   "[ly]",
+	"parsec":                       "pc",
+	"kiloparsec":                   "kpc",
+	"megaparsec":                   "Mpc",
+	"gigaparsec":                   "Gpc",
+}
+
+// distanceRec20 records the UN/ECE Recommendation 20 codes of those units of
+// distance which have one
+var distanceRec20 = map[string]string{
+	"nm":        "C45",
+	"um":        "4H",
+	"mm":        "MMT",
+	"cm":        "CMT",
+	"dm":        "DMT",
+	bunDistance: "MTR",
+	"dam":       "A45",
+	"hm":        "HMT",
+	"km":        "KTM",
+
+	"inch":          "INH",
+	"foot":          "FOT",
+	"yard":          "YRD",
+	"fathom":        "AK",
+	"furlong":       "M50",
+	"mile":          "SMI",
+	"nautical-mile": "NMI",
+	"astro-unit":    "A12",
+	"light-year":    "B57",
+	"parsec":        "C63",
+}
+
+// distanceExternalIDs records the identifiers of the units of distance in
+// external schemes (see Unit.ExternalIDs)
+var distanceExternalIDs = map[string]map[string]string{
+	"mm": {SchemeQUDT: "MilliM", SchemeWikidata: "Q174789", SchemeOM: "millimetre"},
+	"cm": {SchemeQUDT: "CentiM", SchemeWikidata: "Q174728", SchemeOM: "centimetre"},
+	bunDistance: {
+		SchemeQUDT: "M", SchemeWikidata: "Q11573", SchemeOM: "metre",
+	},
+	"km": {SchemeQUDT: "KiloM", SchemeWikidata: "Q828224", SchemeOM: "kilometre"},
+	"inch": {
+		SchemeQUDT: "IN", SchemeWikidata: "Q218593",
+		SchemeOM: "inch-International",
+	},
+	"foot": {
+		SchemeQUDT: "FT", SchemeWikidata: "Q3710",
+		SchemeOM: "foot-International",
+	},
+	"yard": {
+		SchemeQUDT: "YD", SchemeWikidata: "Q482798",
+		SchemeOM: "yard-International",
+	},
+	"mile": {
+		SchemeQUDT: "MI", SchemeWikidata: "Q253276",
+		SchemeOM: "mile-International",
+	},
+	"nautical-mile": {
+		SchemeQUDT: "MI_N", SchemeWikidata: "Q93318",
+		SchemeOM: "nauticalMile-International",
+	},
+	"astro-unit": {
+		SchemeQUDT: "AU", SchemeWikidata: "Q1811",
+		SchemeOM: "astronomicalUnit",
+	},
+	"light-year": {
+		SchemeQUDT: "LY", SchemeWikidata: "Q531", SchemeOM: "lightYear",
+	},
+	"parsec": {
+		SchemeQUDT: "PARSEC", SchemeWikidata: "Q12129", SchemeOM: "parsec",
+	},
+}
@@ -6,7 +6,36 @@ The Unit type holds descriptive and category information about a unit of
 measure and also records details needed in order to convert between units of
 the same family.
 
+Units are retrieved from a Family by name (see the GetUnit method). The name
+may be the canonical name of the unit or one of its aliases. If neither
+matches, differences of case, US or UK spelling, singular or plural form and
+so on are allowed for. The case of abbreviations is not ignored so "MG" will
+not be taken to mean either "mg" or "Mg". The GetUnitStrict method only
+accepts exact names.
+
+A rate Family, such as "volume/time", can be had by passing the names of
+two Families separated by a slash to GetFamily. Its units, such as
+"litre/hour", are made from the units of the two Families.
+
+Units can also be found by their code in the Unified Code for Units of
+Measure (UCUM), for instance "mg/dL" or "[lb_av]"; see GetUCUM and the UCUM
+methods on Unit and ValUnit. Similarly the UN/ECE Recommendation 20 codes
+used in trade documents, for instance "MTR" or "KGM", can be used; see
+GetRec20 and the Unit.Rec20 method. Units are linked to their QUDT, OM and
+Wikidata identifiers (see Unit.ExternalIDs and GetByExternalID) and the
+whole catalogue can be written as RDF using WriteTurtle.
+
 The ValUnit type associates a value with a unit. This can be used to convert
-to other units of the same family. See the Convert method on this type.
+to other units of the same family. See the Convert method on this type. If
+many values are to be converted between the same pair of units a Converter
+(see NewConverter) will do this much more cheaply.
+
+A Formatter can be used to show a ValUnit according to the conventions of a
+Locale, with localised decimal and grouping separators and unit names. It
+can also show values in engineering notation, optionally choosing the unit
+with the appropriate SI prefix. See NewFormatter and the 'l' verb of the
+ValUnit.Format method. A Formatter can also give Unicode, HTML, MathML or
+LaTeX (using the siunitx package) output. The WriteConversionTable method of
+a Family writes a table of its units as Markdown, LaTeX or HTML.
 */
 package units
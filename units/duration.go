@@ -0,0 +1,195 @@
+package units
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrDurationOverflow is wrapped by the error returned when a time is too
+// long to be held in a time.Duration (about 292 years)
+var ErrDurationOverflow = errors.New("the time is out of the range of a time.Duration")
+
+// nsPerSec is the number of nanoseconds in a second, the base unit of time
+const nsPerSec = 1e9
+
+// Duration returns the ValUnit, which must be a time, as a time.Duration,
+// rounded to the nearest nanosecond. A non-nil error is returned if the
+// ValUnit is not a time. If the time is too long to be held in a
+// time.Duration the returned error wraps ErrDurationOverflow and the
+// Duration is saturated at the largest (or smallest) possible value.
+func (v ValUnit) Duration() (time.Duration, error) {
+	if v.U.f != timeFamily {
+		return 0, fmt.Errorf("%s is not a time", v)
+	}
+
+	secs, err := convertToBaseUnits(v.V, v.U)
+	if err != nil {
+		return 0, err
+	}
+
+	ns := math.Round(secs * nsPerSec)
+
+	switch {
+	case math.IsNaN(ns):
+		return 0, fmt.Errorf("%s is not a number", v)
+	case ns >= math.MaxInt64:
+		return math.MaxInt64, fmt.Errorf("%s: %w", v, ErrDurationOverflow)
+	case ns < math.MinInt64:
+		return math.MinInt64, fmt.Errorf("%s: %w", v, ErrDurationOverflow)
+	}
+
+	return time.Duration(ns), nil
+}
+
+// ValUnitFromDuration returns the time.Duration as a ValUnit in seconds
+func ValUnitFromDuration(d time.Duration) ValUnit {
+	u := timeFamily.altUnits[bunTime]
+	u.id = bunTime
+
+	return ValUnit{V: d.Seconds(), U: u}
+}
+
+// ParseDuration parses the string as a time.Duration. The string may be in
+// the form accepted by time.ParseDuration (for instance, "1h30m") or be a
+// number followed by the name of a unit of time (for instance, "2
+// fortnights"); see Family.ParseValUnit. A non-nil error is returned if
+// the string cannot be parsed or the time is too long for a time.Duration.
+func ParseDuration(s string) (time.Duration, error) {
+	if d, err := time.ParseDuration(s); err == nil {
+		return d, nil
+	}
+
+	pvu, err := timeFamily.ParseValUnit(s)
+	if err != nil {
+		return 0, err
+	}
+
+	return pvu.Duration()
+}
+
+// dfltDurationUnits are the units used by FormatDuration if none are given
+var dfltDurationUnits = []string{
+	"year", "week", "day", "hour", "minute", "second",
+}
+
+// durationUnit records the details of a unit used when formatting a
+// time.Duration
+type durationUnit struct {
+	ns               uint64
+	singular, plural string
+}
+
+// newDurationUnit returns the details of the named unit of time. The unit
+// is shown by the name used to find it if that is an alias, otherwise by
+// its name. It returns false if the unit is too long to be used.
+func newDurationUnit(uName string) (durationUnit, bool, error) {
+	u, err := timeFamily.GetUnit(uName)
+	if err != nil {
+		return durationUnit{}, false, err
+	}
+
+	ns := math.Round(u.convFactor * nsPerSec)
+	if ns < 1 {
+		return durationUnit{}, false,
+			fmt.Errorf("a %s is shorter than the resolution of a time.Duration",
+				u.name)
+	}
+
+	if ns > math.MaxInt64 {
+		return durationUnit{}, false, nil
+	}
+
+	du := durationUnit{ns: uint64(ns), singular: u.name, plural: u.namePlural}
+
+	if u.alias != "" {
+		du.singular, du.plural = u.alias, u.alias
+		if _, ok := u.aliases[u.alias+"s"]; ok {
+			du.plural = u.alias + "s"
+		}
+	}
+
+	return du, true, nil
+}
+
+// name returns the name of the unit to show with the value
+func (du durationUnit) name(v float64) string {
+	if v == 1 {
+		return du.singular
+	}
+
+	return du.plural
+}
+
+// FormatDuration returns the time.Duration as a string using the named
+// units of time, for instance "1 year 2 weeks 3 days 4.5 seconds". Each
+// unit is shown with a whole number except for the shortest which may have
+// a fraction. Units with a zero value are not shown. If no units are given
+// years, weeks, days, hours, minutes and seconds are used.
+//
+// A non-nil error is returned if any unit name is not found or the unit is
+// shorter than a nanosecond, the resolution of a time.Duration.
+func FormatDuration(d time.Duration, uNames ...string) (string, error) {
+	if len(uNames) == 0 {
+		uNames = dfltDurationUnits
+	}
+
+	dus := make([]durationUnit, 0, len(uNames))
+
+	for _, uName := range uNames {
+		du, ok, err := newDurationUnit(uName)
+		if err != nil {
+			return "", err
+		}
+
+		if ok {
+			dus = append(dus, du)
+		}
+	}
+
+	if len(dus) == 0 {
+		return "", errors.New("none of the units can be used")
+	}
+
+	slices.SortStableFunc(dus, func(a, b durationUnit) int {
+		switch {
+		case a.ns > b.ns:
+			return -1
+		case a.ns < b.ns:
+			return 1
+		}
+
+		return 0
+	})
+
+	sign := ""
+	mag := uint64(d) //nolint:gosec
+	if d < 0 {
+		sign = "-"
+		mag = uint64(-(d + 1)) + 1 //nolint:gosec
+	}
+
+	parts := []string{}
+
+	for _, du := range dus[:len(dus)-1] {
+		n := mag / du.ns
+		mag %= du.ns
+
+		if n > 0 {
+			parts = append(parts,
+				strconv.FormatUint(n, 10)+" "+du.name(float64(n)))
+		}
+	}
+
+	if last := dus[len(dus)-1]; mag > 0 || len(parts) == 0 {
+		v := float64(mag) / float64(last.ns)
+		parts = append(parts,
+			strconv.FormatFloat(v, 'f', -1, 64)+" "+last.name(v))
+	}
+
+	return sign + strings.Join(parts, " "), nil
+}
@@ -0,0 +1,197 @@
+package units
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestValUnitDuration(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		vu          ValUnit
+		expDur      time.Duration
+		expOverflow bool
+	}{
+		{
+			ID:     testhelper.MkID("minutes"),
+			vu:     ValUnit{V: 90, U: GetOrPanic(Time, "minute")},
+			expDur: 90 * time.Minute,
+		},
+		{
+			ID:     testhelper.MkID("fortnight"),
+			vu:     ValUnit{V: 1, U: GetOrPanic(Time, "fortnight")},
+			expDur: 14 * 24 * time.Hour,
+		},
+		{
+			ID:     testhelper.MkID("rounded to nanoseconds"),
+			vu:     ValUnit{V: 1500, U: GetOrPanic(Time, "psec")},
+			expDur: 2 * time.Nanosecond,
+		},
+		{
+			ID:          testhelper.MkID("too long"),
+			ExpErr:      testhelper.MkExpErr(ErrDurationOverflow.Error()),
+			vu:          ValUnit{V: 300, U: GetOrPanic(Time, "year")},
+			expDur:      math.MaxInt64,
+			expOverflow: true,
+		},
+		{
+			ID:          testhelper.MkID("too long, negative"),
+			ExpErr:      testhelper.MkExpErr(ErrDurationOverflow.Error()),
+			vu:          ValUnit{V: -3, U: GetOrPanic(Time, "century")},
+			expDur:      math.MinInt64,
+			expOverflow: true,
+		},
+		{
+			ID:     testhelper.MkID("not a time"),
+			ExpErr: testhelper.MkExpErr("1 metre is not a time"),
+			vu:     ValUnit{V: 1, U: GetOrPanic(Distance, "metre")},
+		},
+	}
+
+	for _, tc := range testCases {
+		d, err := tc.vu.Duration()
+		testhelper.CheckExpErr(t, err, tc)
+
+		if errors.Is(err, ErrDurationOverflow) != tc.expOverflow {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: the error should wrap ErrDurationOverflow: %t",
+				tc.expOverflow)
+		}
+
+		testhelper.DiffInt(t, tc.IDStr(), "duration", int64(d), int64(tc.expDur))
+	}
+}
+
+func TestValUnitFromDuration(t *testing.T) {
+	vu := ValUnitFromDuration(1500 * time.Millisecond)
+	testhelper.DiffString(t, "1.5s", "ValUnit", vu.String(), "1.5 seconds")
+
+	d, err := vu.Duration()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	testhelper.DiffInt(t, "1.5s", "round trip",
+		int64(d), int64(1500*time.Millisecond))
+}
+
+func TestParseDuration(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		s      string
+		expDur time.Duration
+	}{
+		{
+			ID:     testhelper.MkID("Go syntax"),
+			s:      "1h30m",
+			expDur: 90 * time.Minute,
+		},
+		{
+			ID:     testhelper.MkID("unit name"),
+			s:      "2 fortnights",
+			expDur: 28 * 24 * time.Hour,
+		},
+		{
+			ID:     testhelper.MkID("unit name, fraction"),
+			s:      "1.5 weeks",
+			expDur: 252 * time.Hour,
+		},
+		{
+			ID:     testhelper.MkID("bad unit"),
+			ExpErr: testhelper.MkExpErr(`there is no unit of time called "furlongs"`),
+			s:      "3 furlongs",
+		},
+		{
+			ID:     testhelper.MkID("no number"),
+			ExpErr: testhelper.MkExpErr(`"abc" does not start with a number`),
+			s:      "abc",
+		},
+		{
+			ID:     testhelper.MkID("too long"),
+			ExpErr: testhelper.MkExpErr(ErrDurationOverflow.Error()),
+			s:      "300 years",
+			expDur: math.MaxInt64,
+		},
+	}
+
+	for _, tc := range testCases {
+		d, err := ParseDuration(tc.s)
+		testhelper.CheckExpErr(t, err, tc)
+		testhelper.DiffInt(t, tc.IDStr(), "duration", int64(d), int64(tc.expDur))
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		d      time.Duration
+		uNames []string
+		expStr string
+	}{
+		{
+			ID:     testhelper.MkID("zero"),
+			expStr: "0 seconds",
+		},
+		{
+			ID:     testhelper.MkID("singular and plural"),
+			d:      90 * time.Minute,
+			expStr: "1 hour 30 minutes",
+		},
+		{
+			ID:     testhelper.MkID("negative"),
+			d:      -90 * time.Minute,
+			expStr: "-1 hour 30 minutes",
+		},
+		{
+			ID:     testhelper.MkID("years and a fraction"),
+			d:      400*24*time.Hour + 1500*time.Millisecond,
+			expStr: "1 year 4 weeks 6 days 18 hours 10 minutes 49.5 seconds",
+		},
+		{
+			ID:     testhelper.MkID("largest Duration"),
+			d:      math.MaxInt64,
+			expStr: "292 years 14 weeks 3 days 4 hours 20 minutes 52.854775807 seconds",
+		},
+		{
+			ID:     testhelper.MkID("smallest Duration"),
+			d:      math.MinInt64,
+			expStr: "-292 years 14 weeks 3 days 4 hours 20 minutes 52.854775808 seconds",
+		},
+		{
+			ID:     testhelper.MkID("given units, any order"),
+			d:      45 * 24 * time.Hour,
+			uNames: []string{"hours", "fortnight", "aeon"},
+			expStr: "3 fortnights 72 hours",
+		},
+		{
+			ID:     testhelper.MkID("bad unit"),
+			ExpErr: testhelper.MkExpErr(`there is no unit of time called "nonesuch"`),
+			uNames: []string{"nonesuch"},
+		},
+		{
+			ID: testhelper.MkID("unit too short"),
+			ExpErr: testhelper.MkExpErr(
+				"a picosecond is shorter than the resolution of a time.Duration"),
+			uNames: []string{"psec"},
+		},
+		{
+			ID:     testhelper.MkID("no usable units"),
+			ExpErr: testhelper.MkExpErr("none of the units can be used"),
+			uNames: []string{"aeon"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s, err := FormatDuration(tc.d, tc.uNames...)
+		if testhelper.CheckExpErr(t, err, tc) && err == nil {
+			testhelper.DiffString(t, tc.IDStr(), "duration", s, tc.expStr)
+		}
+	}
+}
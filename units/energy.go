@@ -356,3 +356,99 @@ var energyNames = map[string]Unit{
 		"", "",
 	},
 }
+
+// energyProvenance records the source of the conversion factor for each
+// unit of energy
+var energyProvenance = map[string]Provenance{
+	bunEnergy: provSI,
+	"yJ":      provSI,
+	"zJ":      provSI,
+	"aJ":      provSI,
+	"fJ":      provSI,
+	"pJ":      provSI,
+	"nJ":      provSI,
+	"uJ":      provSI,
+	"mJ":      provSI,
+	"cJ":      provSI,
+	"dJ":      provSI,
+	"daJ":     provSI,
+	"hJ":      provSI,
+	"kJ":      provSI,
+	"MJ":      provSI,
+	"GJ":      provSI,
+	"TJ":      provSI,
+	"PJ":      provSI,
+	"EJ":      provSI,
+	"ZJ":      provSI,
+	"YJ":      provSI,
+
+	"kWh":  provNISTSP811,
+	"erg":  provNISTSP811,
+	"foe":  provColloquial,
+	"cal":  provNISTSP811,
+	"kcal": provNISTSP811,
+
+	"electronvolt": provSI,
+
+	"foot-pound":   provNISTSP811,
+	"foot-poundal": provNISTSP811,
+
+	"BTU": {
+		Kind: ConvConventional, Source: SrcISO31, SigDigits: 6,
+	},
+	"therm": {
+		Kind: ConvConventional, Source: SrcISO31, SigDigits: 6,
+	},
+	"tonOfTNT": provColloquial,
+}
+
+// energySymbols records the typographic symbols of those units of energy
+// whose symbols differ from their abbreviations
+var energySymbols = map[string]string{
+	"uJ":           "µJ",
+	"kWh":          "kW·h",
+	"foot-pound":   "ft·lb",
+	"foot-poundal": "ft·pdl",
+}
+
+// energyUCUM records the UCUM codes of those units of energy which have one
+var energyUCUM = map[string]string{
+	"yJ":      "yJ",
+	"zJ":      "zJ",
+	"aJ":      "aJ",
+	"fJ":      "fJ",
+	"pJ":      "pJ",
+	"nJ":      "nJ",
+	"uJ":      "uJ",
+	"mJ":      "mJ",
+	"cJ":      "cJ",
+	"dJ":      "dJ",
+	bunEnergy: "J",
+	"daJ":     "daJ",
+	"hJ":      "hJ",
+	"kJ":      "kJ",
+	"MJ":      "MJ",
+	"GJ":      "GJ",
+	"TJ":      "TJ",
+	"PJ":      "PJ",
+	"EJ":      "EJ",
+	"ZJ":      "ZJ",
+	"YJ":      "YJ",
+
+	"electronvolt": "eV",
+	"erg":          "erg",
+	"cal":          "cal",
+	"kcal":         "kcal",
+	"BTU":          "[Btu_IT]",
+	"kWh":          "kW.h",
+}
+
+// energyExternalIDs records the identifiers of the units of energy in
+// external schemes (see Unit.ExternalIDs)
+var energyExternalIDs = map[string]map[string]string{
+	bunEnergy: {SchemeQUDT: "J", SchemeWikidata: "Q25269", SchemeOM: "joule"},
+	"electronvolt": {
+		SchemeQUDT: "EV", SchemeWikidata: "Q83327", SchemeOM: "electronvolt",
+	},
+	"kWh": {SchemeQUDT: "KiloW-HR", SchemeOM: "kilowattHour"},
+}
@@ -0,0 +1,306 @@
+package units
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/nickwells/mathutil.mod/v2/mathutil"
+)
+
+// EngStyle describes how a Formatter shows values in engineering notation,
+// that is, with an exponent which is a multiple of three.
+type EngStyle int
+
+// These are the available engineering notation styles. The examples show
+// how 12300 metres would be shown using unit abbreviations.
+const (
+	EngNone     EngStyle = iota // not engineering notation: 12300 m
+	EngTimesTen                 // 12.3×10³ m
+	EngE                        // 12.3e3 m
+	EngSIPrefix                 // 12.3 km
+)
+
+// engStyleNames maps the EngStyle values to a descriptive name
+var engStyleNames = map[EngStyle]string{
+	EngNone:     "none",
+	EngTimesTen: "times-ten",
+	EngE:        "e",
+	EngSIPrefix: "SI-prefix",
+}
+
+// String returns the name of the EngStyle
+func (es EngStyle) String() string {
+	if s, ok := engStyleNames[es]; ok {
+		return s
+	}
+
+	return "EngStyle(" + strconv.Itoa(int(es)) + ")"
+}
+
+// engExpStep is the step between exponents in engineering notation
+const engExpStep = 3
+
+// siPrefixIDs maps the power of ten to the ID of the corresponding SI prefix
+// unit in the dimensionless family
+var siPrefixIDs = map[int]string{
+	-24: "y",
+	-21: "z",
+	-18: "a",
+	-15: "f",
+	-12: "p",
+	-9:  "n",
+	-6:  "u",
+	-3:  "m",
+	-2:  "c",
+	-1:  "d",
+	1:   "da",
+	2:   "h",
+	3:   "k",
+	6:   "M",
+	9:   "G",
+	12:  "T",
+	15:  "P",
+	18:  "E",
+	21:  "Z",
+	24:  "Y",
+}
+
+// siPrefix returns the dimensionless unit for the SI prefix with the given
+// power of ten. It returns false if there is no such prefix.
+func siPrefix(exp int) (Unit, bool) {
+	id, ok := siPrefixIDs[exp]
+	if !ok {
+		return Unit{}, false
+	}
+
+	u, ok := numericFamily.altUnits[id]
+	u.id = id
+
+	return u, ok
+}
+
+// FmtOptEngineering returns a FormatterOpt which causes values to be shown
+// in engineering notation using the given style. When the EngSIPrefix style
+// is used the value is shown in the unit of the same Family having the
+// appropriate SI prefix (for instance, kilometres rather than metres). If
+// the Family has no such unit the value is shown with the SI prefix from
+// the dimensionless Family (k, M, G and so on) before the unit.
+func FmtOptEngineering(style EngStyle) FormatterOpt {
+	return func(f *Formatter) error {
+		f.engStyle = style
+		return nil
+	}
+}
+
+// hasOffset returns true if the unit is converted to its base units with a
+// non-zero addition and so cannot have a prefix applied.
+func (u Unit) hasOffset() bool {
+	return u.convPreAdd != 0 || u.convPostAdd != 0
+}
+
+// findUnitByName returns the unit in the Family having the given name and
+// conversion factor and no offsets
+func (f *Family) findUnitByName(name string, factor float64) (Unit, bool) {
+	const epsilon = 1e-9
+
+	if f.rate != nil {
+		u, ok := f.getRateUnit(name, false)
+
+		return u, ok && !u.hasOffset() &&
+			mathutil.AlmostEqual(u.convFactor/factor, 1, epsilon)
+	}
+
+	for id, u := range f.altUnits {
+		if u.name != name || u.hasOffset() {
+			continue
+		}
+
+		if mathutil.AlmostEqual(u.convFactor/factor, 1, epsilon) {
+			u.id = id
+			return u, true
+		}
+	}
+
+	return Unit{}, false
+}
+
+// splitSIPrefix returns the unit without any SI prefix together with the
+// power of ten given by the prefix. If the unit has no SI prefix (or the
+// unprefixed unit is not in the Family) the unit itself is returned with a
+// zero power.
+func splitSIPrefix(u Unit) (Unit, int) {
+	for exp := range siPrefixIDs {
+		p, ok := siPrefix(exp)
+		if !ok {
+			continue
+		}
+
+		rootName, found := strings.CutPrefix(u.name, p.name)
+		if !found || rootName == "" {
+			continue
+		}
+
+		root, ok := u.f.findUnitByName(rootName, u.convFactor/math.Pow10(exp))
+		if ok {
+			return root, exp
+		}
+	}
+
+	return u, 0
+}
+
+// prefixedUnit returns the unit of the same Family having the given SI
+// prefix applied to the root unit. It returns false if there is no such
+// unit.
+func prefixedUnit(root Unit, exp int) (Unit, bool) {
+	if exp == 0 {
+		return root, true
+	}
+
+	p, ok := siPrefix(exp)
+	if !ok {
+		return Unit{}, false
+	}
+
+	return root.f.findUnitByName(p.name+root.name,
+		root.convFactor*math.Pow10(exp))
+}
+
+// engExponent returns the exponent, a multiple of three, to use when
+// showing the value in engineering notation
+func engExponent(v float64) int {
+	if v == 0 || math.IsInf(v, 0) || math.IsNaN(v) {
+		return 0
+	}
+
+	exp := int(math.Floor(math.Log10(math.Abs(v))))
+
+	return int(math.Floor(float64(exp)/engExpStep)) * engExpStep
+}
+
+// formatMantissa formats the mantissa of a value in engineering
+// notation. If the Formatter has a fixed precision this gives the number of
+// digits after the decimal point, otherwise it gives the number of
+// significant digits and any trailing zeros are removed (unless the
+// Formatter is showing significant figures).
+func (f *Formatter) formatMantissa(m float64) string {
+	if f.fixedPrec {
+		return strconv.FormatFloat(m, 'f', f.precision, 64)
+	}
+
+	if f.sigFigs {
+		return formatSigFigs(m, f.precision)
+	}
+
+	intDigits := 1
+	if am := math.Abs(m); am >= 1 {
+		intDigits = int(math.Floor(math.Log10(am))) + 1
+	}
+
+	s := strconv.FormatFloat(m, 'f', max(f.precision-intDigits, 0), 64)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(s, "0")
+		s = strings.TrimSuffix(s, ".")
+	}
+
+	return s
+}
+
+// engParts splits the value into a formatted mantissa and an exponent
+// which is a multiple of three. The mantissa, as shown, is also returned.
+func (f *Formatter) engParts(v float64) (string, int, float64) {
+	const engLimit = 1000
+
+	exp := engExponent(v)
+	s := f.formatMantissa(v / math.Pow10(exp))
+
+	shown, err := strconv.ParseFloat(s, 64)
+	if err == nil && math.Abs(shown) >= engLimit {
+		exp += engExpStep
+		s = f.formatMantissa(v / math.Pow10(exp))
+		shown, err = strconv.ParseFloat(s, 64)
+	}
+
+	if err != nil {
+		shown = v / math.Pow10(exp)
+	}
+
+	return s, exp, shown
+}
+
+// superscriptDigits maps digits and signs to their superscript forms
+var superscriptDigits = strings.NewReplacer(
+	"0", "⁰", "1", "¹", "2", "²", "3", "³", "4", "⁴",
+	"5", "⁵", "6", "⁶", "7", "⁷", "8", "⁸", "9", "⁹",
+	"-", "⁻",
+)
+
+// expSuffix returns the exponent formatted according to the style
+func expSuffix(style EngStyle, exp int) string {
+	if exp == 0 {
+		return ""
+	}
+
+	switch style {
+	case EngE:
+		return "e" + strconv.Itoa(exp)
+	default:
+		return "×10" + superscriptDigits.Replace(strconv.Itoa(exp))
+	}
+}
+
+// engFmtParts returns the parts of the ValUnit formatted in engineering
+// notation
+func (f *Formatter) engFmtParts(vu ValUnit) fmtParts {
+	v := f.effectiveVal(vu)
+
+	if f.engStyle == EngSIPrefix && vu.U.f != nil && !vu.U.hasOffset() {
+		if p, ok := f.siPrefixedParts(vu, v); ok {
+			return p
+		}
+	}
+
+	s, exp, shown := f.engParts(v)
+
+	return fmtParts{num: s, exp: exp, u: vu.U, shown: shown * math.Pow10(exp)}
+}
+
+// siPrefixedParts returns the parts of the value formatted with an SI
+// prefix. It returns false if the value cannot be shown with a prefix.
+func (f *Formatter) siPrefixedParts(vu ValUnit, v float64) (fmtParts, bool) {
+	root, rootExp := splitSIPrefix(vu.U)
+	rootVal := v * math.Pow10(rootExp)
+
+	s, exp, shown := f.engParts(rootVal)
+
+	if u, ok := prefixedUnit(root, exp); ok {
+		u.alias = ""
+
+		return fmtParts{num: s, u: u, shown: shown}, true
+	}
+
+	// There is no suitably prefixed unit in the Family. A prefix cannot be
+	// added to a unit which already has one so the exponent form is used
+	// instead.
+	if rootExp != 0 {
+		return fmtParts{}, false
+	}
+
+	if exp == 0 {
+		return fmtParts{num: s, u: vu.U, shown: shown}, true
+	}
+
+	p, ok := siPrefix(exp)
+	if !ok {
+		return fmtParts{}, false
+	}
+
+	return fmtParts{
+		num:       s,
+		prefix:    p,
+		prefixExp: exp,
+		u:         vu.U,
+		shown:     shown,
+	}, true
+}
@@ -0,0 +1,130 @@
+package units
+
+import (
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestFormatterEngineering(t *testing.T) {
+	metre := GetOrPanic(Distance, "metre")
+	km := GetOrPanic(Distance, "km")
+	cm := GetOrPanic(Distance, "cm")
+	foot := GetOrPanic(Distance, "foot")
+	kg := GetOrPanic(Mass, "kg")
+
+	testCases := []struct {
+		testhelper.ID
+		opts   []FormatterOpt
+		vu     ValUnit
+		expStr string
+	}{
+		{
+			ID:     testhelper.MkID("times-ten"),
+			opts:   []FormatterOpt{FmtOptEngineering(EngTimesTen), FmtOptAbbrev()},
+			vu:     ValUnit{V: 12300, U: metre},
+			expStr: "12.3×10³ m",
+		},
+		{
+			ID:     testhelper.MkID("times-ten, negative exponent"),
+			opts:   []FormatterOpt{FmtOptEngineering(EngTimesTen), FmtOptAbbrev()},
+			vu:     ValUnit{V: -0.5, U: metre},
+			expStr: "-500×10⁻³ m",
+		},
+		{
+			ID:     testhelper.MkID("times-ten, plural"),
+			opts:   []FormatterOpt{FmtOptEngineering(EngTimesTen)},
+			vu:     ValUnit{V: 1000, U: metre},
+			expStr: "1×10³ metres",
+		},
+		{
+			ID:     testhelper.MkID("e"),
+			opts:   []FormatterOpt{FmtOptEngineering(EngE), FmtOptAbbrev()},
+			vu:     ValUnit{V: 12300, U: metre},
+			expStr: "12.3e3 m",
+		},
+		{
+			ID:     testhelper.MkID("e, rounded up to next exponent"),
+			opts:   []FormatterOpt{FmtOptEngineering(EngE), FmtOptAbbrev()},
+			vu:     ValUnit{V: 999999, U: metre},
+			expStr: "1e6 m",
+		},
+		{
+			ID:     testhelper.MkID("e, zero"),
+			opts:   []FormatterOpt{FmtOptEngineering(EngE), FmtOptAbbrev()},
+			vu:     ValUnit{V: 0, U: metre},
+			expStr: "0 m",
+		},
+		{
+			ID: testhelper.MkID("e, fixed precision, German"),
+			opts: []FormatterOpt{
+				FmtOptEngineering(EngE),
+				FmtOptPrecision(2),
+				FmtOptLocaleName(LocaleGerman),
+			},
+			vu:     ValUnit{V: 12300, U: metre},
+			expStr: "12,30e3 Meter",
+		},
+		{
+			ID:     testhelper.MkID("SI prefix"),
+			opts:   []FormatterOpt{FmtOptEngineering(EngSIPrefix), FmtOptAbbrev()},
+			vu:     ValUnit{V: 12300, U: metre},
+			expStr: "12.3 km",
+		},
+		{
+			ID:     testhelper.MkID("SI prefix, from prefixed unit"),
+			opts:   []FormatterOpt{FmtOptEngineering(EngSIPrefix)},
+			vu:     ValUnit{V: 0.0123, U: km},
+			expStr: "12.3 metres",
+		},
+		{
+			ID:     testhelper.MkID("SI prefix, from non-engineering prefix"),
+			opts:   []FormatterOpt{FmtOptEngineering(EngSIPrefix), FmtOptAbbrev()},
+			vu:     ValUnit{V: 1500, U: cm},
+			expStr: "15 m",
+		},
+		{
+			ID:     testhelper.MkID("SI prefix, mass"),
+			opts:   []FormatterOpt{FmtOptEngineering(EngSIPrefix)},
+			vu:     ValUnit{V: 0.00002, U: kg},
+			expStr: "20 milligrams",
+		},
+		{
+			ID:     testhelper.MkID("SI prefix, singular"),
+			opts:   []FormatterOpt{FmtOptEngineering(EngSIPrefix)},
+			vu:     ValUnit{V: 1000, U: metre},
+			expStr: "1 kilometre",
+		},
+		{
+			ID:     testhelper.MkID("SI prefix, no prefixed unit"),
+			opts:   []FormatterOpt{FmtOptEngineering(EngSIPrefix), FmtOptAbbrev()},
+			vu:     ValUnit{V: 12300, U: foot},
+			expStr: "12.3k ft",
+		},
+		{
+			ID:     testhelper.MkID("SI prefix, prefixed unit, no prefixed root"),
+			opts:   []FormatterOpt{FmtOptEngineering(EngSIPrefix), FmtOptAbbrev()},
+			vu:     ValUnit{V: 1500, U: kg},
+			expStr: "1.5×10³ kg",
+		},
+		{
+			ID:     testhelper.MkID("SI prefix, no bigger prefixed unit"),
+			opts:   []FormatterOpt{FmtOptEngineering(EngSIPrefix), FmtOptAbbrev()},
+			vu:     ValUnit{V: 2000, U: GetOrPanic(Energy, "YJ")},
+			expStr: "2×10³ YJ",
+		},
+	}
+
+	for _, tc := range testCases {
+		f := NewFormatterOrPanic(tc.opts...)
+		testhelper.DiffString(t, tc.IDStr(), "formatted value",
+			f.Format(tc.vu), tc.expStr)
+	}
+}
+
+func TestEngStyleString(t *testing.T) {
+	testhelper.DiffString(t, "EngSIPrefix", "string",
+		EngSIPrefix.String(), "SI-prefix")
+	testhelper.DiffString(t, "bad EngStyle", "string",
+		EngStyle(99).String(), "EngStyle(99)")
+}
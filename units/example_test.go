@@ -23,3 +23,17 @@ func ExampleValUnit_Convert() {
 	fmt.Printf("%s = %s\n", vInInches, vInFeet)
 	// Output: 12 inches = 1 foot
 }
+
+// ExampleQuantity demonstrates the use of the generic Quantity type. A
+// Quantity[units.DistanceF] can only be combined with another distance;
+// adding a Quantity[units.MassF] would not compile.
+func ExampleQuantity() {
+	height := units.NewQuantityOrPanic[units.DistanceF](6, "foot")
+	extra := units.NewQuantityOrPanic[units.DistanceF](6, "inch")
+
+	total := height.Add(extra)
+	metres, _ := total.In("metre")
+
+	fmt.Printf("%.4f metres\n", metres)
+	// Output: 1.9812 metres
+}
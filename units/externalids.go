@@ -0,0 +1,274 @@
+package units
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// These are the external identifier schemes used as keys in the map
+// returned by Unit.ExternalIDs
+const (
+	// SchemeQUDT gives the IRI of the unit in the QUDT units vocabulary,
+	// for instance "http://qudt.org/vocab/unit/M"
+	SchemeQUDT = "qudt"
+	// SchemeWikidata gives the Wikidata item id of the unit, for instance
+	// "Q11573"
+	SchemeWikidata = "wikidata"
+	// SchemeOM gives the name of the unit in the Ontology of units of
+	// Measure (OM 2.0), for instance "metre"
+	SchemeOM = "om"
+)
+
+// These are the namespaces of the external identifier schemes
+const (
+	QUDTUnitNS = "http://qudt.org/vocab/unit/"
+	WikidataNS = "http://www.wikidata.org/entity/"
+	OMNS       = "http://www.ontology-of-units-of-measure.org/resource/om-2/"
+)
+
+// These are the other namespaces used in the Turtle output
+const (
+	qudtNS = "http://qudt.org/schema/qudt/"
+	rdfsNS = "http://www.w3.org/2000/01/rdf-schema#"
+	skosNS = "http://www.w3.org/2004/02/skos/core#"
+	owlNS  = "http://www.w3.org/2002/07/owl#"
+)
+
+// turtleIndent is the indentation of the properties of a unit in the
+// Turtle output
+const turtleIndent = "    "
+
+// externalScheme records the namespace of an external identifier scheme
+// and whether the identifier is given as a full IRI
+type externalScheme struct {
+	ns      string
+	fullIRI bool
+}
+
+// externalSchemes holds the details of the known external identifier
+// schemes
+var externalSchemes = map[string]externalScheme{
+	SchemeQUDT:     {ns: QUDTUnitNS, fullIRI: true},
+	SchemeWikidata: {ns: WikidataNS},
+	SchemeOM:       {ns: OMNS},
+}
+
+// externalIDIndex maps each external identifier scheme to a map of the
+// identifiers (without any namespace) to the corresponding Unit. It is
+// populated from the externalIDs values in the entries in unitFamilies.
+var externalIDIndex = map[string]map[string]Unit{}
+
+// populateExternalIDIndex adds the external identifiers of the units of
+// the Family to the index
+func (f *Family) populateExternalIDIndex() {
+	for id, ids := range f.externalIDs {
+		u, ok := f.altUnits[id]
+		if !ok {
+			panic(
+				fmt.Errorf(
+					"the external ids on Family %q are for an unknown Unit: %q",
+					f.name, id))
+		}
+
+		u.id = id
+
+		for scheme, extID := range ids {
+			if _, ok := externalSchemes[scheme]; !ok {
+				panic(
+					fmt.Errorf(
+						"Unit %q (%s) has an id in an unknown scheme: %q",
+						id, f.name, scheme))
+			}
+
+			idx, ok := externalIDIndex[scheme]
+			if !ok {
+				idx = map[string]Unit{}
+				externalIDIndex[scheme] = idx
+			}
+
+			if other, ok := idx[extID]; ok {
+				panic(
+					fmt.Errorf(
+						"there is a duplicate %s id:"+
+							" Unit %q (%s) has the id %q and so does Unit %q (%s)",
+						scheme, id, f.name, extID, other.id, other.f.name))
+			}
+
+			idx[extID] = u
+		}
+	}
+}
+
+// ExternalIDs returns the identifiers of the Unit in external schemes,
+// keyed by the scheme (SchemeQUDT, SchemeWikidata or SchemeOM). Only those
+// schemes in which the Unit has an identifier are present. The map is a
+// copy and may be changed freely.
+//
+// Identifiers are given for the base unit of every Family and for the
+// most commonly used units, such as the kilometre, the pound or the litre.
+// Most units, in particular those with an SI prefix and historical or
+// colloquial units, have none.
+func (u Unit) ExternalIDs() map[string]string {
+	rval := map[string]string{}
+
+	if u.f == nil {
+		return rval
+	}
+
+	for scheme, extID := range u.f.externalIDs[u.id] {
+		if externalSchemes[scheme].fullIRI {
+			extID = externalSchemes[scheme].ns + extID
+		}
+
+		rval[scheme] = extID
+	}
+
+	return rval
+}
+
+// GetByExternalID returns the Unit having the identifier in the external
+// scheme. The identifier may be given either with or without the namespace
+// of the scheme, so both "Q11573" and "http://www.wikidata.org/entity/Q11573"
+// will find the metre. A non-nil error is returned if the scheme is not
+// known or no Unit has the identifier.
+func GetByExternalID(scheme, extID string) (Unit, error) {
+	es, ok := externalSchemes[scheme]
+	if !ok {
+		return Unit{},
+			fmt.Errorf("there is no external identifier scheme called %q",
+				scheme)
+	}
+
+	u, ok := externalIDIndex[scheme][strings.TrimPrefix(extID, es.ns)]
+	if !ok {
+		return Unit{},
+			fmt.Errorf("there is no unit with the %s id %q", scheme, extID)
+	}
+
+	return u, nil
+}
+
+// GetByExternalIDOrPanic calls GetByExternalID and panics if the error is
+// non-nil, otherwise it returns the Unit.
+func GetByExternalIDOrPanic(scheme, extID string) Unit {
+	u, err := GetByExternalID(scheme, extID)
+	if err != nil {
+		panic(err)
+	}
+
+	return u
+}
+
+// turtleString returns the string as a Turtle string literal
+func turtleString(s string) string {
+	return `"` + turtleEscaper.Replace(s) + `"`
+}
+
+// turtleEscaper escapes the characters which may not appear in a Turtle
+// string literal
+var turtleEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+)
+
+// turtleIRI returns the IRI of the unit in the catalogue with the given base
+func turtleIRI(base string, u Unit) string {
+	return "<" + base +
+		url.PathEscape(u.f.name) + "/" + url.PathEscape(u.id) + ">"
+}
+
+// turtleUnit writes the Turtle description of the Unit
+func turtleUnit(sb *strings.Builder, base string, u Unit) {
+	props := []string{
+		"a qudt:Unit",
+		"rdfs:label " + turtleString(u.name) + "@en",
+		"skos:notation " + turtleString(u.abbrev),
+	}
+
+	if u.notes != "" {
+		props = append(props,
+			"qudt:plainTextDescription "+turtleString(u.notes))
+	}
+
+	if sym := u.Symbol(); sym != "" {
+		props = append(props, "qudt:symbol "+turtleString(sym))
+	}
+
+	if code, ok := u.UCUM(); ok {
+		props = append(props, "qudt:ucumCode "+turtleString(code))
+	}
+
+	if code, ok := u.Rec20(); ok {
+		props = append(props, "qudt:uneceCommonCode "+turtleString(code))
+	}
+
+	ids := u.f.externalIDs[u.id]
+
+	if extID, ok := ids[SchemeQUDT]; ok {
+		props = append(props, "owl:sameAs unit:"+extID)
+	}
+
+	if extID, ok := ids[SchemeOM]; ok {
+		props = append(props, "skos:exactMatch om:"+extID)
+	}
+
+	if extID, ok := ids[SchemeWikidata]; ok {
+		props = append(props, "skos:exactMatch wd:"+extID)
+	}
+
+	sb.WriteString(turtleIRI(base, u) + "\n" + turtleIndent)
+	sb.WriteString(strings.Join(props, " ;\n"+turtleIndent))
+	sb.WriteString(" .\n")
+}
+
+// WriteTurtle writes the catalogue of units as RDF in Turtle syntax to the
+// Writer. Each unit is given an IRI formed from the base IRI followed by
+// the Family name and the unit ID and is described as a qudt:Unit with its
+// name, abbreviation, symbol and any UCUM or UN/ECE Recommendation 20
+// code. Any external identifiers (see Unit.ExternalIDs) are given as links
+// to the QUDT, OM and Wikidata resources. The Families and units are
+// written in alphabetical order.
+//
+// A non-nil error is returned if the Writer fails.
+func WriteTurtle(w io.Writer, base string) error {
+	var sb strings.Builder
+
+	for _, p := range []struct{ prefix, ns string }{
+		{"rdfs", rdfsNS},
+		{"skos", skosNS},
+		{"owl", owlNS},
+		{"qudt", qudtNS},
+		{"unit", QUDTUnitNS},
+		{"om", OMNS},
+		{"wd", WikidataNS},
+	} {
+		fmt.Fprintf(&sb, "@prefix %s: <%s> .\n", p.prefix, p.ns)
+	}
+
+	families := GetFamilies()
+	slices.SortFunc(families, func(a, b *Family) int {
+		return strings.Compare(a.name, b.name)
+	})
+
+	for _, f := range families {
+		units := f.GetUnits()
+		slices.SortFunc(units, func(a, b Unit) int {
+			return strings.Compare(a.id, b.id)
+		})
+
+		for _, u := range units {
+			sb.WriteString("\n")
+			turtleUnit(&sb, base, u)
+		}
+	}
+
+	_, err := io.WriteString(w, sb.String())
+
+	return err
+}
@@ -0,0 +1,150 @@
+package units
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestExternalIDs(t *testing.T) {
+	for _, f := range unitFamilies {
+		for id := range f.externalIDs {
+			u := f.altUnits[id]
+			u.id = id
+
+			for scheme, extID := range u.ExternalIDs() {
+				got, err := GetByExternalID(scheme, extID)
+				if err != nil {
+					t.Errorf("%s: %q: unexpected error: %s", f.name, id, err)
+					continue
+				}
+
+				if got.f != f || got.id != id {
+					t.Errorf("%s: %s id %q: expected unit %q, got %q (%s)",
+						f.name, scheme, extID, id, got.id, got.f.name)
+				}
+			}
+		}
+	}
+
+	metre := distanceFamily.GetUnitOrPanic("metre")
+	ids := metre.ExternalIDs()
+	testhelper.DiffString(t, "metre", SchemeQUDT,
+		ids[SchemeQUDT], "http://qudt.org/vocab/unit/M")
+	testhelper.DiffString(t, "metre", SchemeWikidata,
+		ids[SchemeWikidata], "Q11573")
+	testhelper.DiffString(t, "metre", SchemeOM, ids[SchemeOM], "metre")
+
+	smoot := distanceFamily.GetUnitOrPanic("smoot")
+	testhelper.DiffInt(t, "smoot", "number of ids", len(smoot.ExternalIDs()), 0)
+}
+
+func TestBaseUnitExternalIDs(t *testing.T) {
+	for _, f := range unitFamilies {
+		ids := f.GetUnitOrPanic(f.baseUnitName).ExternalIDs()
+
+		for _, scheme := range []string{SchemeQUDT, SchemeOM} {
+			if _, ok := ids[scheme]; !ok {
+				t.Errorf("%s: the base unit %q has no %s id",
+					f.name, f.baseUnitName, scheme)
+			}
+		}
+	}
+}
+
+func TestGetByExternalID(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		scheme string
+		extID  string
+		expFam string
+		expID  string
+	}{
+		{
+			ID:     testhelper.MkID("QUDT IRI"),
+			scheme: SchemeQUDT,
+			extID:  "http://qudt.org/vocab/unit/KiloGM",
+			expFam: "mass",
+			expID:  "kg",
+		},
+		{
+			ID:     testhelper.MkID("QUDT local name"),
+			scheme: SchemeQUDT,
+			extID:  "DEG_F",
+			expFam: "temperature",
+			expID:  "F",
+		},
+		{
+			ID:     testhelper.MkID("Wikidata Q-id"),
+			scheme: SchemeWikidata,
+			extID:  "Q11574",
+			expFam: "time",
+			expID:  "second",
+		},
+		{
+			ID:     testhelper.MkID("Wikidata entity IRI"),
+			scheme: SchemeWikidata,
+			extID:  "http://www.wikidata.org/entity/Q11582",
+			expFam: "volume",
+			expID:  "litre",
+		},
+		{
+			ID:     testhelper.MkID("OM name"),
+			scheme: SchemeOM,
+			extID:  "foot-International",
+			expFam: "distance",
+			expID:  "foot",
+		},
+		{
+			ID:     testhelper.MkID("unknown scheme"),
+			scheme: "ucum",
+			extID:  "m",
+			ExpErr: testhelper.MkExpErr(
+				`there is no external identifier scheme called "ucum"`),
+		},
+		{
+			ID:     testhelper.MkID("unknown id"),
+			scheme: SchemeWikidata,
+			extID:  "Q1",
+			ExpErr: testhelper.MkExpErr(`there is no unit with the wikidata id "Q1"`),
+		},
+	}
+
+	for _, tc := range testCases {
+		u, err := GetByExternalID(tc.scheme, tc.extID)
+		if testhelper.CheckExpErr(t, err, tc) && err == nil {
+			testhelper.DiffString(t, tc.IDStr(), "family", u.f.name, tc.expFam)
+			testhelper.DiffString(t, tc.IDStr(), "unit ID", u.id, tc.expID)
+		}
+	}
+}
+
+func TestWriteTurtle(t *testing.T) {
+	var sb strings.Builder
+
+	if err := WriteTurtle(&sb, "https://example.org/units/"); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	s := sb.String()
+
+	for _, exp := range []string{
+		"@prefix unit: <http://qudt.org/vocab/unit/> .\n",
+		"\n<https://example.org/units/distance/metre>\n" +
+			"    a qudt:Unit ;\n" +
+			`    rdfs:label "metre"@en ;` + "\n",
+		`    qudt:ucumCode "m" ;` + "\n" +
+			`    qudt:uneceCommonCode "MTR" ;` + "\n" +
+			"    owl:sameAs unit:M ;\n" +
+			"    skos:exactMatch om:metre ;\n" +
+			"    skos:exactMatch wd:Q11573 .\n",
+		"<https://example.org/units/velocity/kilometre%2Fhour>\n",
+		"<https://example.org/units/distance/US%20survey%20foot>\n",
+	} {
+		if !strings.Contains(s, exp) {
+			t.Errorf("the Turtle output does not contain:\n%s", exp)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package units
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -24,6 +25,16 @@ type Family struct {
 	altUnits      map[string]Unit
 	unitAliases   map[string]string
 	familyAliases []string
+
+	unitProvenance map[string]Provenance
+	unitSymbols    map[string]string
+	calendarMonths map[string]int
+	unitUCUM       map[string]string
+	unitRec20      map[string]string
+	externalIDs    map[string]map[string]string
+	normNames      []map[string]normEntry
+
+	rate *rateFamily
 }
 
 // BaseUnitName returns the name of the base unit for this family.
@@ -45,7 +56,7 @@ func (f *Family) Name() string {
 // String returns a string representation of the Family object.
 func (f *Family) String() string {
 	rval := fmt.Sprintf("%s: a %s. With %d units. Base unit: %s.",
-		f.name, f.description, len(f.altUnits), f.baseUnitName)
+		f.name, f.description, f.unitCount(), f.baseUnitName)
 	if len(f.familyAliases) > 0 {
 		rval += " Aliases: " + strings.Join(f.familyAliases, ", ")
 	}
@@ -157,9 +168,69 @@ func init() {
 	angleFamily.altUnits = angleNames
 	energyFamily.altUnits = energyNames
 
+	numericFamily.unitProvenance = dimensionlessProvenance
+	timeFamily.unitProvenance = timeProvenance
+	dataFamily.unitProvenance = dataProvenance
+	distanceFamily.unitProvenance = distanceProvenance
+	areaFamily.unitProvenance = areaProvenance
+	volumeFamily.unitProvenance = volumeProvenance
+	velocityFamily.unitProvenance = velocityProvenance
+	massFamily.unitProvenance = massProvenance
+	pressureFamily.unitProvenance = pressureProvenance
+	temperatureFamily.unitProvenance = temperatureProvenance
+	angleFamily.unitProvenance = angleProvenance
+	energyFamily.unitProvenance = energyProvenance
+
+	numericFamily.unitSymbols = dimensionlessSymbols
+	timeFamily.unitSymbols = timeSymbols
+	areaFamily.unitSymbols = areaSymbols
+	volumeFamily.unitSymbols = volumeSymbols
+	velocityFamily.unitSymbols = velocitySymbols
+	massFamily.unitSymbols = massSymbols
+	energyFamily.unitSymbols = energySymbols
+
+	timeFamily.calendarMonths = timeCalendarMonths
+
+	numericFamily.unitUCUM = dimensionlessUCUM
+	timeFamily.unitUCUM = timeUCUM
+	dataFamily.unitUCUM = dataUCUM
+	distanceFamily.unitUCUM = distanceUCUM
+	areaFamily.unitUCUM = areaUCUM
+	volumeFamily.unitUCUM = volumeUCUM
+	velocityFamily.unitUCUM = velocityUCUM
+	massFamily.unitUCUM = massUCUM
+	pressureFamily.unitUCUM = pressureUCUM
+	temperatureFamily.unitUCUM = temperatureUCUM
+	angleFamily.unitUCUM = angleUCUM
+	energyFamily.unitUCUM = energyUCUM
+
+	timeFamily.unitRec20 = timeRec20
+	dataFamily.unitRec20 = dataRec20
+	distanceFamily.unitRec20 = distanceRec20
+	areaFamily.unitRec20 = areaRec20
+	volumeFamily.unitRec20 = volumeRec20
+	massFamily.unitRec20 = massRec20
+
+	numericFamily.externalIDs = dimensionlessExternalIDs
+	timeFamily.externalIDs = timeExternalIDs
+	dataFamily.externalIDs = dataExternalIDs
+	distanceFamily.externalIDs = distanceExternalIDs
+	areaFamily.externalIDs = areaExternalIDs
+	volumeFamily.externalIDs = volumeExternalIDs
+	velocityFamily.externalIDs = velocityExternalIDs
+	massFamily.externalIDs = massExternalIDs
+	pressureFamily.externalIDs = pressureExternalIDs
+	temperatureFamily.externalIDs = temperatureExternalIDs
+	angleFamily.externalIDs = angleExternalIDs
+	energyFamily.externalIDs = energyExternalIDs
+
 	for _, f := range unitFamilies {
 		f.populateUnitAliases()
+		f.populateNormalisedNames()
 		f.populateFamilyAliases()
+		f.populateUCUMIndex()
+		f.populateRec20Index()
+		f.populateExternalIDIndex()
 	}
 }
 
@@ -167,11 +238,21 @@ func init() {
 // Family name is not found.
 //
 // You are advised to use the constant Family names provided.
+//
+// A name made of two Family names separated by a slash, for instance
+// "volume/time", gives a rate Family. This has a unit for every pair of
+// units from the two Families, such as "litre/hour", with the conversion
+// factor calculated from theirs. Rate Families are created when first
+// asked for and are not returned by GetFamilies or GetFamilyNames.
 func GetFamily(fName string) (*Family, error) {
 	f, ok := unitFamilies[fName]
 	if !ok {
 		alias, ok := familyAlias[fName]
 		if !ok {
+			if strings.Contains(fName, rateSep) {
+				return getRateFamily(fName)
+			}
+
 			return nil, fmt.Errorf("there is no unit family called %q", fName)
 		}
 
@@ -235,9 +316,60 @@ func GetFamilies() []*Family {
 	return families
 }
 
-// GetUnit gets the named unit from the UnitDetails. A non-nil error is
-// returned if the name is not found.
+// GetUnit gets the named unit from the UnitDetails. If the name does not
+// exactly match a unit name or alias then a normalised form of the name is
+// tried; this allows for differences of case, US or UK spelling, singular or
+// plural forms and so on (use GetUnitStrict if this is not wanted). A non-nil
+// error is returned if the name is not found. If the name is not found the
+// error will be an *UnknownUnitError which will hold suggestions of
+// similarly named units.
 func (f *Family) GetUnit(uName string) (Unit, error) {
+	u, err := f.getUnit(uName)
+
+	return u, f.addSuggestions(err)
+}
+
+// getUnit gets the named unit from the Family as described for
+// GetUnit. Any *UnknownUnitError returned will not have any suggestions;
+// these are expensive to find and are not wanted when the name is only
+// being tried (as when looking for rate units).
+func (f *Family) getUnit(uName string) (Unit, error) {
+	u, err := f.getUnitStrict(uName)
+	if err == nil {
+		return u, nil
+	}
+
+	var uue *UnknownUnitError
+	if !errors.As(err, &uue) {
+		return u, err
+	}
+
+	if nu, ok := f.getUnitNormalised(uName); ok {
+		return nu, nil
+	}
+
+	if f.rate != nil {
+		if ru, ok := f.getRateUnit(uName, false); ok {
+			return ru, nil
+		}
+	}
+
+	return u, err
+}
+
+// GetUnitStrict gets the named unit from the Family. Unlike GetUnit, the
+// name must exactly match either the name of a unit or one of its
+// aliases. A non-nil error is returned if the name is not found.
+func (f *Family) GetUnitStrict(uName string) (Unit, error) {
+	u, err := f.getUnitStrict(uName)
+
+	return u, f.addSuggestions(err)
+}
+
+// getUnitStrict gets the named unit from the Family as described for
+// GetUnitStrict. Any *UnknownUnitError returned will not have any
+// suggestions.
+func (f *Family) getUnitStrict(uName string) (Unit, error) {
 	u, ok := f.altUnits[uName]
 	if ok {
 		u.id = uName
@@ -248,8 +380,13 @@ func (f *Family) GetUnit(uName string) (Unit, error) {
 
 	uName, ok = f.unitAliases[alias]
 	if !ok {
-		return u, fmt.Errorf("there is no %s called %q",
-			f.description, alias)
+		if f.rate != nil {
+			if ru, ok := f.getRateUnit(alias, true); ok {
+				return ru, nil
+			}
+		}
+
+		return u, &UnknownUnitError{Family: f, Name: alias}
 	}
 
 	u, ok = f.altUnits[uName]
@@ -265,8 +402,14 @@ func (f *Family) GetUnit(uName string) (Unit, error) {
 }
 
 // GetUnits returns a slice of all the units in the family.  Note that the
-// slice is not sorted and the order of elements may vary.
+// slice is not sorted and the order of elements may vary. For a rate Family
+// the units are made afresh on each call and there may be very many of
+// them.
 func (f *Family) GetUnits() []Unit {
+	if f.rate != nil {
+		return f.rateUnits()
+	}
+
 	units := make([]Unit, 0, len(f.altUnits))
 	for id, u := range f.altUnits {
 		u.id = id
@@ -291,6 +434,17 @@ func (f *Family) GetUnitOrPanic(uName string) Unit {
 // aliases) in this family. Note that it is not sorted and so will have a
 // random order which may vary between calls to this function.
 func (f *Family) GetUnitNames() []string {
+	if f.rate != nil {
+		units := f.rateUnits()
+
+		names := make([]string, 0, len(units))
+		for _, u := range units {
+			names = append(names, u.id)
+		}
+
+		return names
+	}
+
 	names := make([]string, 0, len(f.altUnits))
 
 	for n := range f.altUnits {
@@ -331,6 +485,19 @@ func Get(fName, uName string) (Unit, error) {
 	return f.GetUnit(uName)
 }
 
+// GetStrict returns the [Unit] with the given uName from the [Family] with
+// the given fName. Unlike Get, the unit name must exactly match either the
+// name of a unit or one of its aliases. It returns an appropriate error if
+// either the [Family] or the [Unit] are not found.
+func GetStrict(fName, uName string) (Unit, error) {
+	f, err := GetFamily(fName)
+	if err != nil {
+		return Unit{}, err
+	}
+
+	return f.GetUnitStrict(uName)
+}
+
 // GetOrPanic returns the [Unit] with the given uName from the [Family] with the
 // given fName. It panics if either the [Family] or the
 // [Unit] are not found.
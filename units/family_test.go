@@ -66,6 +66,43 @@ func checkFamilyUnit(t *testing.T, fName, uName string, f *Family, u Unit) {
 			t.Errorf("\t: the tag is not in the global tags map\n")
 		}
 	}
+
+	checkFamilyUnitProvenance(t, fName, uName, f)
+}
+
+// checkFamilyUnitProvenance checks that the provenance of the named unit is
+// recorded and is consistent.
+func checkFamilyUnitProvenance(t *testing.T, fName, uName string, f *Family) {
+	t.Helper()
+
+	p, ok := f.unitProvenance[uName]
+	if !ok {
+		t.Logf("Bad family / unit: %q / %q", fName, uName)
+		t.Errorf("\t: the unit has no provenance\n")
+
+		return
+	}
+
+	if p.Kind == ConvUnknown {
+		t.Logf("Bad family / unit: %q / %q", fName, uName)
+		t.Errorf("\t: the unit's provenance has an unknown kind\n")
+	}
+
+	if p.Source == "" {
+		t.Logf("Bad family / unit: %q / %q", fName, uName)
+		t.Errorf("\t: the unit's provenance has no source\n")
+	}
+
+	if p.Kind == ConvExact && p.SigDigits != 0 {
+		t.Logf("Bad family / unit: %q / %q", fName, uName)
+		t.Logf("\t: SigDigits: %d\n", p.SigDigits)
+		t.Errorf("\t: an exact conversion cannot have limited precision\n")
+	}
+
+	if p.Kind == ConvMeasured && p.SigDigits == 0 {
+		t.Logf("Bad family / unit: %q / %q", fName, uName)
+		t.Errorf("\t: a measured conversion must give its precision\n")
+	}
 }
 
 func TestValidUnits(t *testing.T) {
@@ -85,6 +122,49 @@ func TestValidUnits(t *testing.T) {
 		for uName, u := range f.altUnits {
 			checkFamilyUnit(t, fName, uName, f, u)
 		}
+
+		for uName := range f.unitProvenance {
+			if _, ok := f.altUnits[uName]; !ok {
+				t.Logf("Bad family: %q", fName)
+				t.Logf("\t: Unit: %q\n", uName)
+				t.Error("\t: provenance is given for a non-existent unit\n")
+			}
+		}
+
+		for uName, sym := range f.unitSymbols {
+			if _, ok := f.altUnits[uName]; !ok {
+				t.Logf("Bad family: %q", fName)
+				t.Logf("\t: Unit: %q\n", uName)
+				t.Error("\t: a symbol is given for a non-existent unit\n")
+			}
+
+			if sym == "" {
+				t.Logf("Bad family: %q", fName)
+				t.Logf("\t: Unit: %q\n", uName)
+				t.Error("\t: the symbol is empty\n")
+			}
+		}
+	}
+
+	for lName, l := range locales {
+		for fName, names := range l.UnitNames {
+			f, ok := unitFamilies[fName]
+			if !ok {
+				t.Logf("Bad locale: %q", lName)
+				t.Errorf("\t: unit names are given for a non-existent family: %q\n",
+					fName)
+
+				continue
+			}
+
+			for uName := range names {
+				if _, ok := f.altUnits[uName]; !ok {
+					t.Logf("Bad locale: %q", lName)
+					t.Logf("\t: Family: %q, Unit: %q\n", fName, uName)
+					t.Error("\t: a name is given for a non-existent unit\n")
+				}
+			}
+		}
 	}
 }
 
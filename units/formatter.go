@@ -0,0 +1,310 @@
+package units
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"sync/atomic"
+)
+
+// dfltFmtPrecision is the number of significant digits shown by a Formatter
+// if no precision is given. This matches the ValUnit.String method.
+const dfltFmtPrecision = 5
+
+// Formatter formats a ValUnit according to the rules of a Locale. By default
+// it uses the English Locale, shows the value to 5 significant digits and
+// uses the full name of the unit; with these defaults the result is the
+// same as the ValUnit.String method except that large numbers have their
+// digits grouped.
+//
+// A Formatter should be created with NewFormatter and is safe for
+// concurrent use once created.
+type Formatter struct {
+	locale     *Locale
+	useAbbrev  bool
+	precision  int
+	fixedPrec  bool
+	sigFigs    bool
+	engStyle   EngStyle
+	typography Typography
+}
+
+// FormatterOpt is the type of an option that can be passed to NewFormatter
+type FormatterOpt func(*Formatter) error
+
+// FmtOptLocale returns a FormatterOpt which sets the Locale to use. The
+// Formatter takes a copy of the Locale so later changes to it will not
+// affect the Formatter.
+func FmtOptLocale(l *Locale) FormatterOpt {
+	return func(f *Formatter) error {
+		if l == nil {
+			return errors.New("the Locale must not be nil")
+		}
+
+		f.locale = l.clone()
+
+		return nil
+	}
+}
+
+// FmtOptLocaleName returns a FormatterOpt which sets the Locale to the
+// named built-in Locale
+func FmtOptLocaleName(name string) FormatterOpt {
+	return func(f *Formatter) error {
+		l, err := GetLocale(name)
+		if err != nil {
+			return err
+		}
+
+		f.locale = l
+
+		return nil
+	}
+}
+
+// FmtOptAbbrev returns a FormatterOpt which causes the unit to be shown by
+// its abbreviation rather than its name
+func FmtOptAbbrev() FormatterOpt {
+	return func(f *Formatter) error {
+		f.useAbbrev = true
+		return nil
+	}
+}
+
+// FmtOptPrecision returns a FormatterOpt which causes the value to be shown
+// with the given number of digits after the decimal point.
+func FmtOptPrecision(prec int) FormatterOpt {
+	return func(f *Formatter) error {
+		if prec < 0 {
+			return errors.New("the precision must not be negative")
+		}
+
+		f.precision = prec
+		f.fixedPrec = true
+		f.sigFigs = false
+
+		return nil
+	}
+}
+
+// FmtOptSigFigs returns a FormatterOpt which causes the value to be shown
+// with the given number of significant figures. Unlike the default format,
+// any trailing zeros are kept so that, for instance, 1.5 is shown as 1.50
+// to 3 significant figures.
+func FmtOptSigFigs(n int) FormatterOpt {
+	return func(f *Formatter) error {
+		if n < 1 {
+			return errors.New(
+				"the number of significant figures must be at least 1")
+		}
+
+		f.precision = n
+		f.fixedPrec = false
+		f.sigFigs = true
+
+		return nil
+	}
+}
+
+// NewFormatter creates a new Formatter and applies the options. It returns
+// a non-nil error if any option fails.
+func NewFormatter(opts ...FormatterOpt) (*Formatter, error) {
+	f := &Formatter{
+		locale:    locales[LocaleEnglish],
+		precision: dfltFmtPrecision,
+	}
+
+	for _, o := range opts {
+		if err := o(f); err != nil {
+			return nil, err
+		}
+	}
+
+	return f, nil
+}
+
+// NewFormatterOrPanic calls NewFormatter and panics if the error is
+// non-nil, otherwise it returns the Formatter.
+func NewFormatterOrPanic(opts ...FormatterOpt) *Formatter {
+	f, err := NewFormatter(opts...)
+	if err != nil {
+		panic(err)
+	}
+
+	return f
+}
+
+// Locale returns a copy of the Locale used by the Formatter
+func (f *Formatter) Locale() *Locale {
+	return f.locale.clone()
+}
+
+// withPrecision returns a copy of the Formatter with the precision set to
+// the given number of digits after the decimal point
+func (f *Formatter) withPrecision(prec int) *Formatter {
+	rval := *f
+	rval.precision = prec
+	rval.fixedPrec = true
+	rval.sigFigs = false
+
+	return &rval
+}
+
+// withSigFigs returns a copy of the Formatter set to show the given number
+// of significant figures
+func (f *Formatter) withSigFigs(n int) *Formatter {
+	rval := *f
+	rval.precision = n
+	rval.fixedPrec = false
+	rval.sigFigs = true
+
+	return &rval
+}
+
+// These give the range of powers of ten outside of which numbers shown to a
+// number of significant figures are given in exponential form.
+const (
+	minSigFigsExp = -5
+	maxSigFigsExp = 21
+)
+
+// formatSigFigs returns the value formatted to the given number of
+// significant figures, keeping any trailing zeros.
+func formatSigFigs(v float64, n int) string {
+	if math.IsInf(v, 0) || math.IsNaN(v) {
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+
+	s := strconv.FormatFloat(v, 'e', n-1, 64)
+
+	r, err := strconv.ParseFloat(s, 64)
+	if err != nil || r == 0 {
+		return strconv.FormatFloat(0, 'f', n-1, 64)
+	}
+
+	exp := int(math.Floor(math.Log10(math.Abs(r))))
+	if exp < minSigFigsExp || exp >= maxSigFigsExp {
+		return s
+	}
+
+	return strconv.FormatFloat(r, 'f', max(n-1-exp, 0), 64)
+}
+
+// effectiveVal returns the value to be formatted. Values very close to zero
+// or one are rounded to those values (see ValUnit.effectiveVal) unless the
+// value is being shown to a number of significant figures or in
+// engineering notation in which case small values are significant.
+func (f *Formatter) effectiveVal(vu ValUnit) float64 {
+	if f.fixedPrec || (!f.sigFigs && f.engStyle == EngNone) {
+		return vu.effectiveVal(f.precision)
+	}
+
+	return vu.V
+}
+
+// formatNumber returns the value formatted (but not localised) together
+// with the value as shown, after any rounding.
+func (f *Formatter) formatNumber(vu ValUnit) (string, float64) {
+	eVal := f.effectiveVal(vu)
+
+	var s string
+
+	switch {
+	case f.fixedPrec:
+		s = strconv.FormatFloat(eVal, 'f', f.precision, 64)
+	case f.sigFigs:
+		s = formatSigFigs(eVal, f.precision)
+	default:
+		s = strconv.FormatFloat(eVal, 'g', f.precision, 64)
+	}
+
+	shown, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		shown = eVal
+	}
+
+	return s, shown
+}
+
+// unitName returns the name of the unit to show with the given value
+func (f *Formatter) unitName(vu ValUnit, shown float64) string {
+	if f.useAbbrev && vu.U.abbrev != "" {
+		return vu.U.abbrev
+	}
+
+	if name, ok := f.locale.unitName(vu.U, shown); ok {
+		return name
+	}
+
+	singularName, pluralName := vu.unitNames()
+	if EnglishPluralRule(shown) == PluralOne {
+		return singularName
+	}
+
+	return pluralName
+}
+
+// fmtParts holds the parts of a formatted ValUnit before they are combined
+type fmtParts struct {
+	num       string  // the number, before localisation
+	exp       int     // any power of ten (for engineering notation)
+	prefix    Unit    // any SI prefix to be shown after the number
+	prefixExp int     // the power of ten given by the prefix
+	u         Unit    // the unit to show
+	shown     float64 // the value, as shown, used to choose the unit name
+}
+
+// parts returns the parts of the formatted ValUnit
+func (f *Formatter) parts(vu ValUnit) fmtParts {
+	if f.engStyle != EngNone {
+		return f.engFmtParts(vu)
+	}
+
+	s, shown := f.formatNumber(vu)
+
+	return fmtParts{num: s, u: vu.U, shown: shown}
+}
+
+// Format returns the ValUnit formatted according to the Formatter settings.
+func (f *Formatter) Format(vu ValUnit) string {
+	p := f.parts(vu)
+
+	switch f.typography {
+	case TypoUnicode:
+		return f.renderUnicode(p)
+	case TypoLaTeX:
+		return f.renderLaTeX(p)
+	case TypoHTML:
+		return f.renderHTML(p)
+	case TypoMathML:
+		return f.renderMathML(p)
+	}
+
+	return f.locale.localiseNumber(p.num) + expSuffix(f.engStyle, p.exp) +
+		p.prefix.abbrev + " " + f.unitName(ValUnit{V: p.shown, U: p.u}, p.shown)
+}
+
+// defaultFormatter is the Formatter used for the 'l' verb of the
+// ValUnit.Format method
+var defaultFormatter atomic.Pointer[Formatter]
+
+func init() {
+	defaultFormatter.Store(NewFormatterOrPanic())
+}
+
+// DefaultFormatter returns the Formatter used when a ValUnit is formatted
+// with the 'l' verb (for instance, fmt.Printf("%l", vu)).
+func DefaultFormatter() *Formatter {
+	return defaultFormatter.Load()
+}
+
+// SetDefaultFormatter sets the Formatter used when a ValUnit is formatted
+// with the 'l' verb. If f is nil a Formatter with the default settings is
+// used.
+func SetDefaultFormatter(f *Formatter) {
+	if f == nil {
+		f = NewFormatterOrPanic()
+	}
+
+	defaultFormatter.Store(f)
+}
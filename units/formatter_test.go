@@ -0,0 +1,284 @@
+package units
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestFormatter(t *testing.T) {
+	km := GetOrPanic(Distance, "km")
+	metre := GetOrPanic(Distance, "metre")
+	litre := GetOrPanic(Volume, "litre")
+	day := GetOrPanic(Time, "day")
+	kelvin := GetOrPanic(Temperature, "K")
+	smpl := SampleFamily.GetUnitOrPanic(SampleUnitBaseAlias)
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		opts   []FormatterOpt
+		vu     ValUnit
+		expStr string
+	}{
+		{
+			ID:     testhelper.MkID("default, as String"),
+			vu:     ValUnit{V: 42, U: smpl},
+			expStr: "42 samples (smpl)",
+		},
+		{
+			ID:     testhelper.MkID("default, singular"),
+			vu:     ValUnit{V: 1.000001, U: km},
+			expStr: "1 kilometre",
+		},
+		{
+			ID:     testhelper.MkID("default, grouped"),
+			vu:     ValUnit{V: -12345.6, U: km},
+			expStr: "-12,346 kilometres",
+		},
+		{
+			ID:     testhelper.MkID("default, exponent"),
+			vu:     ValUnit{V: 1234567, U: km},
+			expStr: "1.2346e+06 kilometres",
+		},
+		{
+			ID:     testhelper.MkID("precision"),
+			opts:   []FormatterOpt{FmtOptPrecision(2)},
+			vu:     ValUnit{V: 1234.5, U: km},
+			expStr: "1,234.50 kilometres",
+		},
+		{
+			ID: testhelper.MkID("abbrev, fr"),
+			opts: []FormatterOpt{
+				FmtOptLocaleName(LocaleFrench),
+				FmtOptAbbrev(),
+				FmtOptPrecision(1),
+			},
+			vu:     ValUnit{V: 1234.5, U: km},
+			expStr: "1\u202f234,5 km",
+		},
+		{
+			ID: testhelper.MkID("de"),
+			opts: []FormatterOpt{
+				FmtOptLocaleName(LocaleGerman),
+				FmtOptPrecision(1),
+			},
+			vu:     ValUnit{V: 1234.5, U: km},
+			expStr: "1.234,5 Kilometer",
+		},
+		{
+			ID:     testhelper.MkID("fr, singular below 2"),
+			opts:   []FormatterOpt{FmtOptLocaleName(LocaleFrench)},
+			vu:     ValUnit{V: 1.5, U: litre},
+			expStr: "1,5 litre",
+		},
+		{
+			ID:     testhelper.MkID("fr, no local name"),
+			opts:   []FormatterOpt{FmtOptLocaleName(LocaleFrench)},
+			vu:     ValUnit{V: 1.5, U: GetOrPanic(Distance, "furlong")},
+			expStr: "1,5 furlongs",
+		},
+		{
+			ID:     testhelper.MkID("es, not grouped"),
+			opts:   []FormatterOpt{FmtOptLocaleName(LocaleSpanish)},
+			vu:     ValUnit{V: 1234, U: metre},
+			expStr: "1234 metros",
+		},
+		{
+			ID:     testhelper.MkID("es, grouped"),
+			opts:   []FormatterOpt{FmtOptLocaleName(LocaleSpanish)},
+			vu:     ValUnit{V: 12345, U: metre},
+			expStr: "12.345 metros",
+		},
+		{
+			ID:     testhelper.MkID("pl, few"),
+			opts:   []FormatterOpt{FmtOptLocaleName(LocalePolish)},
+			vu:     ValUnit{V: 22, U: day},
+			expStr: "22 dni",
+		},
+		{
+			ID:     testhelper.MkID("pl, many"),
+			opts:   []FormatterOpt{FmtOptLocaleName(LocalePolish)},
+			vu:     ValUnit{V: 12, U: metre},
+			expStr: "12 metrów",
+		},
+		{
+			ID:     testhelper.MkID("pl, fraction"),
+			opts:   []FormatterOpt{FmtOptLocaleName(LocalePolish)},
+			vu:     ValUnit{V: 2.5, U: metre},
+			expStr: "2,5 metra",
+		},
+		{
+			ID:     testhelper.MkID("pl, kelvin"),
+			opts:   []FormatterOpt{FmtOptLocaleName(LocalePolish)},
+			vu:     ValUnit{V: 5, U: kelvin},
+			expStr: "5 kelwinów",
+		},
+		{
+			ID:     testhelper.MkID("bad locale"),
+			opts:   []FormatterOpt{FmtOptLocaleName("xx")},
+			ExpErr: testhelper.MkExpErr(`there is no locale called "xx"`),
+		},
+		{
+			ID:     testhelper.MkID("nil locale"),
+			opts:   []FormatterOpt{FmtOptLocale(nil)},
+			ExpErr: testhelper.MkExpErr("the Locale must not be nil"),
+		},
+		{
+			ID:     testhelper.MkID("bad precision"),
+			opts:   []FormatterOpt{FmtOptPrecision(-1)},
+			ExpErr: testhelper.MkExpErr("the precision must not be negative"),
+		},
+	}
+
+	for _, tc := range testCases {
+		f, err := NewFormatter(tc.opts...)
+		if !testhelper.CheckExpErr(t, err, tc) || err != nil {
+			continue
+		}
+
+		testhelper.DiffString(t, tc.IDStr(), "formatted value",
+			f.Format(tc.vu), tc.expStr)
+	}
+}
+
+func TestPluralRules(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		rule   PluralRule
+		v      float64
+		expCat PluralCategory
+	}{
+		{
+			ID:     testhelper.MkID("en-1"),
+			rule:   EnglishPluralRule,
+			v:      1,
+			expCat: PluralOne,
+		},
+		{
+			ID:     testhelper.MkID("en-0"),
+			rule:   EnglishPluralRule,
+			v:      0,
+			expCat: PluralOther,
+		},
+		{
+			ID:     testhelper.MkID("fr-0"),
+			rule:   FrenchPluralRule,
+			v:      0,
+			expCat: PluralOne,
+		},
+		{
+			ID:     testhelper.MkID("fr-2"),
+			rule:   FrenchPluralRule,
+			v:      2,
+			expCat: PluralOther,
+		},
+		{
+			ID:     testhelper.MkID("pl-1"),
+			rule:   PolishPluralRule,
+			v:      1,
+			expCat: PluralOne,
+		},
+		{
+			ID:     testhelper.MkID("pl-3"),
+			rule:   PolishPluralRule,
+			v:      3,
+			expCat: PluralFew,
+		},
+		{
+			ID:     testhelper.MkID("pl-13"),
+			rule:   PolishPluralRule,
+			v:      13,
+			expCat: PluralMany,
+		},
+		{
+			ID:     testhelper.MkID("pl-104"),
+			rule:   PolishPluralRule,
+			v:      104,
+			expCat: PluralFew,
+		},
+		{
+			ID:     testhelper.MkID("pl-1.5"),
+			rule:   PolishPluralRule,
+			v:      1.5,
+			expCat: PluralOther,
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffInt(t, tc.IDStr(), "plural category",
+			int(tc.rule(tc.v)), int(tc.expCat))
+	}
+}
+
+func TestValUnitFormatL(t *testing.T) {
+	vu := ValUnit{V: 1234.5, U: GetOrPanic(Distance, "km")}
+
+	testhelper.DiffString(t, "default formatter", "%l",
+		fmt.Sprintf("%l", vu), "1,234.5 kilometres")
+	testhelper.DiffString(t, "default formatter", "%.2l",
+		fmt.Sprintf("%.2l", vu), "1,234.50 kilometres")
+
+	SetDefaultFormatter(NewFormatterOrPanic(
+		FmtOptLocaleName(LocaleGerman), FmtOptAbbrev()))
+	defer SetDefaultFormatter(nil)
+
+	testhelper.DiffString(t, "German formatter", "%l",
+		fmt.Sprintf("%l", vu), "1.234,5 km")
+	testhelper.DiffString(t, "German formatter", "%12l",
+		fmt.Sprintf("%12l", vu), "  1.234,5 km")
+}
+
+func TestGetLocale(t *testing.T) {
+	testhelper.DiffStringSlice(t, "built-in locales", "names",
+		GetLocaleNames(),
+		[]string{
+			LocaleGerman, LocaleEnglish, LocaleSpanish,
+			LocaleFrench, LocalePolish,
+		})
+
+	for _, name := range GetLocaleNames() {
+		l := GetLocaleOrPanic(name)
+		testhelper.DiffString(t, name, "locale name", l.Name, name)
+
+		for fName, unitNames := range l.UnitNames {
+			f, err := GetFamily(fName)
+			if err != nil {
+				t.Errorf("locale %q: %v", name, err)
+				continue
+			}
+
+			for uID := range unitNames {
+				if _, err := f.GetUnitStrict(uID); err != nil {
+					t.Errorf("locale %q: %v", name, err)
+				}
+			}
+		}
+	}
+
+	l := GetLocaleOrPanic(LocaleFrench)
+	l.DecimalSep = "!"
+	l.UnitNames[Distance]["metre"][PluralOther] = "changed"
+
+	NewFormatterOrPanic().Locale().DecimalSep = "!"
+
+	vu := ValUnit{V: 1.5, U: GetOrPanic(Distance, "metre")}
+	testhelper.DiffString(t, "changed copy of a locale", "formatted value",
+		NewFormatterOrPanic(FmtOptLocaleName(LocaleFrench)).Format(vu),
+		"1,5 mètre")
+	testhelper.DiffString(t, "changed copy of a locale", "English value",
+		NewFormatterOrPanic().Format(vu), "1.5 metres")
+
+	tc := struct {
+		testhelper.ID
+		testhelper.ExpPanic
+	}{
+		ID:       testhelper.MkID("bad locale name"),
+		ExpPanic: testhelper.MkExpPanic(`there is no locale called "xx"`),
+	}
+	panicked, panicVal := testhelper.PanicSafe(func() {
+		GetLocaleOrPanic("xx")
+	})
+	testhelper.CheckExpPanicError(t, panicked, panicVal, tc)
+}
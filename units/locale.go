@@ -0,0 +1,423 @@
+package units
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+)
+
+// PluralCategory identifies the grammatical form that a unit name should
+// take for a given value. Different languages use different numbers of
+// categories; English only uses PluralOne and PluralOther.
+type PluralCategory int
+
+// These are the plural categories. They follow the Unicode CLDR categories
+// of the same names.
+const (
+	PluralOther PluralCategory = iota
+	PluralOne
+	PluralFew
+	PluralMany
+)
+
+// PluralRule returns the plural category to be used for the given value. The
+// value will be the value as displayed (after any rounding).
+type PluralRule func(v float64) PluralCategory
+
+// UnitNameForms maps a plural category to the form of the unit name to use
+// for that category. If there is no entry for the category the PluralOther
+// form is used.
+type UnitNameForms map[PluralCategory]string
+
+// Locale records the rules for formatting a ValUnit in a given language or
+// region. A Locale is used by a Formatter.
+//
+// The UnitNames are keyed by Family name and then by Unit ID. Any unit not
+// given a local name will use its standard (English) name.
+type Locale struct {
+	Name              string
+	DecimalSep        string
+	GroupSep          string
+	GroupSize         int
+	MinGroupingDigits int
+	PluralRule        PluralRule
+	UnitNames         map[string]map[string]UnitNameForms
+}
+
+// unitName returns the local name of the unit for the given value. It
+// returns false if the Locale has no name for the unit.
+func (l *Locale) unitName(u Unit, v float64) (string, bool) {
+	if u.f == nil {
+		return "", false
+	}
+
+	forms, ok := l.UnitNames[u.f.name][u.id]
+	if !ok {
+		return "", false
+	}
+
+	if l.PluralRule != nil {
+		if name, ok := forms[l.PluralRule(v)]; ok {
+			return name, true
+		}
+	}
+
+	name, ok := forms[PluralOther]
+
+	return name, ok
+}
+
+// groupDigits inserts the group separator into the string of digits
+func (l *Locale) groupDigits(digits string) string {
+	if l.GroupSize <= 0 || l.GroupSep == "" {
+		return digits
+	}
+
+	if len(digits) < l.GroupSize+max(l.MinGroupingDigits, 1) {
+		return digits
+	}
+
+	var sb strings.Builder
+
+	lead := len(digits) % l.GroupSize
+	if lead == 0 {
+		lead = l.GroupSize
+	}
+
+	sb.WriteString(digits[:lead])
+
+	for i := lead; i < len(digits); i += l.GroupSize {
+		sb.WriteString(l.GroupSep)
+		sb.WriteString(digits[i : i+l.GroupSize])
+	}
+
+	return sb.String()
+}
+
+// localiseNumber takes a number formatted by the strconv package and
+// replaces the decimal point and inserts any group separators. Numbers in
+// exponential form are not grouped.
+func (l *Locale) localiseNumber(s string) string {
+	sign := ""
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		sign, s = s[:1], s[1:]
+	}
+
+	if s == "" || s[0] < '0' || s[0] > '9' { // Inf or NaN
+		return sign + s
+	}
+
+	mantissa, exponent := s, ""
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		mantissa, exponent = s[:i], s[i:]
+	}
+
+	intPart, fracPart, hasFrac := strings.Cut(mantissa, ".")
+
+	if exponent == "" {
+		intPart = l.groupDigits(intPart)
+	}
+
+	rval := sign + intPart
+	if hasFrac {
+		rval += l.DecimalSep + fracPart
+	}
+
+	return rval + exponent
+}
+
+// EnglishPluralRule is the PluralRule for English (and for German, Spanish
+// and many other languages). Only the value 1 is singular.
+func EnglishPluralRule(v float64) PluralCategory {
+	if v == 1 {
+		return PluralOne
+	}
+
+	return PluralOther
+}
+
+// FrenchPluralRule is the PluralRule for French. Values less than 2 are
+// singular.
+func FrenchPluralRule(v float64) PluralCategory {
+	if math.Abs(v) < 2 { //nolint:mnd
+		return PluralOne
+	}
+
+	return PluralOther
+}
+
+// PolishPluralRule is the PluralRule for Polish. Whole numbers ending in 2,
+// 3 or 4 (but not 12, 13 or 14) take the PluralFew form, other whole
+// numbers apart from 1 take the PluralMany form and fractional values take
+// the PluralOther form.
+func PolishPluralRule(v float64) PluralCategory {
+	v = math.Abs(v)
+	if v != math.Trunc(v) || v > math.MaxInt64 {
+		return PluralOther
+	}
+
+	n := int64(v)
+	if n == 1 {
+		return PluralOne
+	}
+
+	n10, n100 := n%10, n%100 //nolint:mnd
+	if n10 >= 2 && n10 <= 4 && (n100 < 12 || n100 > 14) {
+		return PluralFew
+	}
+
+	return PluralMany
+}
+
+// These Locale name constants should be used when retrieving the built-in
+// locales (using the GetLocale or GetLocaleOrPanic funcs).
+const (
+	LocaleEnglish = "en"
+	LocaleGerman  = "de"
+	LocaleFrench  = "fr"
+	LocaleSpanish = "es"
+	LocalePolish  = "pl"
+)
+
+// forms returns the UnitNameForms for a language with only singular and
+// plural forms
+func forms(one, other string) UnitNameForms {
+	return UnitNameForms{PluralOne: one, PluralOther: other}
+}
+
+// plForms returns the UnitNameForms for a Polish unit name
+func plForms(one, few, many, other string) UnitNameForms {
+	return UnitNameForms{
+		PluralOne:   one,
+		PluralFew:   few,
+		PluralMany:  many,
+		PluralOther: other,
+	}
+}
+
+var locales = map[string]*Locale{
+	LocaleEnglish: {
+		Name:       LocaleEnglish,
+		DecimalSep: ".",
+		GroupSep:   ",",
+		GroupSize:  3, //nolint:mnd
+		PluralRule: EnglishPluralRule,
+	},
+	LocaleGerman: {
+		Name:       LocaleGerman,
+		DecimalSep: ",",
+		GroupSep:   ".",
+		GroupSize:  3, //nolint:mnd
+		PluralRule: EnglishPluralRule,
+		UnitNames: map[string]map[string]UnitNameForms{
+			Distance: {
+				"mm":    forms("Millimeter", "Millimeter"),
+				"cm":    forms("Zentimeter", "Zentimeter"),
+				"metre": forms("Meter", "Meter"),
+				"km":    forms("Kilometer", "Kilometer"),
+				"inch":  forms("Zoll", "Zoll"),
+				"foot":  forms("Fuß", "Fuß"),
+				"mile":  forms("Meile", "Meilen"),
+			},
+			Mass: {
+				"gram":  forms("Gramm", "Gramm"),
+				"kg":    forms("Kilogramm", "Kilogramm"),
+				"tonne": forms("Tonne", "Tonnen"),
+				"pound": forms("Pfund", "Pfund"),
+			},
+			Volume: {
+				"ml":    forms("Milliliter", "Milliliter"),
+				"litre": forms("Liter", "Liter"),
+			},
+			Time: {
+				"second": forms("Sekunde", "Sekunden"),
+				"minute": forms("Minute", "Minuten"),
+				"hour":   forms("Stunde", "Stunden"),
+				"day":    forms("Tag", "Tage"),
+				"week":   forms("Woche", "Wochen"),
+			},
+			Temperature: {
+				"C": forms("Grad Celsius", "Grad Celsius"),
+				"K": forms("Kelvin", "Kelvin"),
+			},
+		},
+	},
+	LocaleFrench: {
+		Name:       LocaleFrench,
+		DecimalSep: ",",
+		GroupSep:   "\u202f", // narrow no-break space
+		GroupSize:  3,        //nolint:mnd
+		PluralRule: FrenchPluralRule,
+		UnitNames: map[string]map[string]UnitNameForms{
+			Distance: {
+				"mm":    forms("millimètre", "millimètres"),
+				"cm":    forms("centimètre", "centimètres"),
+				"metre": forms("mètre", "mètres"),
+				"km":    forms("kilomètre", "kilomètres"),
+				"inch":  forms("pouce", "pouces"),
+				"foot":  forms("pied", "pieds"),
+				"mile":  forms("mile", "miles"),
+			},
+			Mass: {
+				"gram":  forms("gramme", "grammes"),
+				"kg":    forms("kilogramme", "kilogrammes"),
+				"tonne": forms("tonne", "tonnes"),
+				"pound": forms("livre", "livres"),
+			},
+			Volume: {
+				"ml":    forms("millilitre", "millilitres"),
+				"litre": forms("litre", "litres"),
+			},
+			Time: {
+				"second": forms("seconde", "secondes"),
+				"minute": forms("minute", "minutes"),
+				"hour":   forms("heure", "heures"),
+				"day":    forms("jour", "jours"),
+				"week":   forms("semaine", "semaines"),
+			},
+			Temperature: {
+				"C": forms("degré Celsius", "degrés Celsius"),
+				"K": forms("kelvin", "kelvins"),
+			},
+		},
+	},
+	LocaleSpanish: {
+		Name:              LocaleSpanish,
+		DecimalSep:        ",",
+		GroupSep:          ".",
+		GroupSize:         3, //nolint:mnd
+		MinGroupingDigits: 2, //nolint:mnd
+		PluralRule:        EnglishPluralRule,
+		UnitNames: map[string]map[string]UnitNameForms{
+			Distance: {
+				"mm":    forms("milímetro", "milímetros"),
+				"cm":    forms("centímetro", "centímetros"),
+				"metre": forms("metro", "metros"),
+				"km":    forms("kilómetro", "kilómetros"),
+				"inch":  forms("pulgada", "pulgadas"),
+				"foot":  forms("pie", "pies"),
+				"mile":  forms("milla", "millas"),
+			},
+			Mass: {
+				"gram":  forms("gramo", "gramos"),
+				"kg":    forms("kilogramo", "kilogramos"),
+				"tonne": forms("tonelada", "toneladas"),
+				"pound": forms("libra", "libras"),
+			},
+			Volume: {
+				"ml":    forms("mililitro", "mililitros"),
+				"litre": forms("litro", "litros"),
+			},
+			Time: {
+				"second": forms("segundo", "segundos"),
+				"minute": forms("minuto", "minutos"),
+				"hour":   forms("hora", "horas"),
+				"day":    forms("día", "días"),
+				"week":   forms("semana", "semanas"),
+			},
+			Temperature: {
+				"C": forms("grado Celsius", "grados Celsius"),
+				"K": forms("kelvin", "kelvins"),
+			},
+		},
+	},
+	LocalePolish: {
+		Name:              LocalePolish,
+		DecimalSep:        ",",
+		GroupSep:          "\u00a0", // no-break space
+		GroupSize:         3,        //nolint:mnd
+		MinGroupingDigits: 2,        //nolint:mnd
+		PluralRule:        PolishPluralRule,
+		UnitNames: map[string]map[string]UnitNameForms{
+			Distance: {
+				"mm": plForms(
+					"milimetr", "milimetry", "milimetrów", "milimetra"),
+				"cm": plForms(
+					"centymetr", "centymetry", "centymetrów", "centymetra"),
+				"metre": plForms("metr", "metry", "metrów", "metra"),
+				"km": plForms(
+					"kilometr", "kilometry", "kilometrów", "kilometra"),
+				"inch": plForms("cal", "cale", "cali", "cala"),
+				"foot": plForms("stopa", "stopy", "stóp", "stopy"),
+				"mile": plForms("mila", "mile", "mil", "mili"),
+			},
+			Mass: {
+				"gram": plForms("gram", "gramy", "gramów", "grama"),
+				"kg": plForms(
+					"kilogram", "kilogramy", "kilogramów", "kilograma"),
+				"tonne": plForms("tona", "tony", "ton", "tony"),
+				"pound": plForms("funt", "funty", "funtów", "funta"),
+			},
+			Volume: {
+				"ml": plForms(
+					"mililitr", "mililitry", "mililitrów", "mililitra"),
+				"litre": plForms("litr", "litry", "litrów", "litra"),
+			},
+			Time: {
+				"second": plForms("sekunda", "sekundy", "sekund", "sekundy"),
+				"minute": plForms("minuta", "minuty", "minut", "minuty"),
+				"hour":   plForms("godzina", "godziny", "godzin", "godziny"),
+				"day":    plForms("dzień", "dni", "dni", "dnia"),
+				"week": plForms(
+					"tydzień", "tygodnie", "tygodni", "tygodnia"),
+			},
+			Temperature: {
+				"C": plForms(
+					"stopień Celsjusza", "stopnie Celsjusza",
+					"stopni Celsjusza", "stopnia Celsjusza"),
+				"K": plForms("kelwin", "kelwiny", "kelwinów", "kelwina"),
+			},
+		},
+	},
+}
+
+// clone returns a deep copy of the Locale
+func (l *Locale) clone() *Locale {
+	rval := *l
+
+	if l.UnitNames != nil {
+		rval.UnitNames = make(map[string]map[string]UnitNameForms,
+			len(l.UnitNames))
+
+		for fName, unitNames := range l.UnitNames {
+			names := make(map[string]UnitNameForms, len(unitNames))
+			for uID, forms := range unitNames {
+				names[uID] = maps.Clone(forms)
+			}
+
+			rval.UnitNames[fName] = names
+		}
+	}
+
+	return &rval
+}
+
+// GetLocale returns a copy of the named built-in Locale. It returns a
+// non-nil error if there is no such Locale. The copy may be changed without
+// affecting the built-in Locale or any Formatter using it.
+func GetLocale(name string) (*Locale, error) {
+	l, ok := locales[name]
+	if !ok {
+		return nil, fmt.Errorf("there is no locale called %q", name)
+	}
+
+	return l.clone(), nil
+}
+
+// GetLocaleOrPanic returns a copy of the named built-in Locale. It panics
+// if there is no such Locale.
+func GetLocaleOrPanic(name string) *Locale {
+	l, err := GetLocale(name)
+	if err != nil {
+		panic(err)
+	}
+
+	return l
+}
+
+// GetLocaleNames returns the sorted names of the built-in locales.
+func GetLocaleNames() []string {
+	return slices.Sorted(maps.Keys(locales))
+}
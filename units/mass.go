@@ -533,3 +533,163 @@ var massNames = map[string]Unit{
 		"", "",
 	},
 }
+
+// massProvenance records the source of the conversion factor for each unit
+// of mass
+var massProvenance = map[string]Provenance{
+	bunMass:     provSI,
+	"yg":        provSI,
+	"zg":        provSI,
+	"ag":        provSI,
+	"fg":        provSI,
+	"pg":        provSI,
+	"ng":        provSI,
+	"ug":        provSI,
+	"mg":        provSI,
+	"cg":        provSI,
+	"dg":        provSI,
+	"dag":       provSI,
+	"hg":        provSI,
+	"kg":        provSI,
+	"myg":       provFrenchMetric,
+	"tonne":     provSI,
+	"kilotonne": provSI,
+	"Tg":        provSI,
+	"megatonne": provSI,
+	"Pg":        provSI,
+	"Eg":        provSI,
+	"Zg":        provSI,
+	"Yg":        provSI,
+
+	"grain":      provIntlYard,
+	"troy-ounce": provIntlYard,
+	"scruple":    provIntlYard,
+	"dram":       provIntlYard,
+	"drachm":     provIntlYard,
+
+	"electronvolt":     provSI,
+	"gigaelectronvolt": provSI,
+
+	"dalton": {
+		Kind: ConvMeasured, Source: SrcCODATA2022, SigDigits: 12,
+	},
+	"kilodalton": {
+		Kind: ConvDerived, Source: SrcCODATA2022, SigDigits: 12,
+	},
+	"megadalton": {
+		Kind: ConvDerived, Source: SrcCODATA2022, SigDigits: 12,
+	},
+
+	"ounce":               provIntlYard,
+	"pound":               provIntlYard,
+	"stone":               provIntlYard,
+	"hundredweight":       provIntlYard,
+	"short-hundredweight": provIntlYard,
+	"imperial-ton":        provIntlYard,
+	"short-ton":           provIntlYard,
+
+	"earth-mass": {
+		Kind: ConvMeasured, Source: SrcIAU2015, SigDigits: 5,
+	},
+	"solar-mass": {
+		Kind: ConvMeasured, Source: SrcIAU2015, SigDigits: 6,
+	},
+	"lunar-mass": {
+		Kind: ConvMeasured, Source: SrcNASAFactSheet, SigDigits: 4,
+	},
+}
+
+// massSymbols records the typographic symbols of those units of mass whose
+// symbols differ from their abbreviations
+var massSymbols = map[string]string{
+	"tonne":     "t",
+	"kilotonne": "kt",
+	"megatonne": "Mt",
+}
+
+// massUCUM records the UCUM codes of those units of mass which have one
+var massUCUM = map[string]string{
+	"yg":    "yg",
+	"zg":    "zg",
+	"ag":    "ag",
+	"fg":    "fg",
+	"pg":    "pg",
+	"ng":    "ng",
+	"ug":    "ug",
+	"mg":    "mg",
+	"cg":    "cg",
+	"dg":    "dg",
+	bunMass: "g",
+	"dag":   "dag",
+	"hg":    "hg",
+	"kg":    "kg",
+	"Tg":    "Tg",
+	"Pg":    "Pg",
+	"Eg":    "Eg",
+	"Zg":    "Zg",
+	"Yg":    "Yg",
+
+	"tonne":      "t",
+	"kilotonne":  "kt",
+	"megatonne":  "Mt",
+	"dalton":     "u",
+	"grain":      "[gr]",
+	"scruple":    "[sc_ap]",
+	"drachm":     "[dr_ap]",
+	"ounce":      "[oz_av]",
+	"troy-ounce": "[oz_tr]",
+	"pound":      "[lb_av]",
+	"stone":      "[stone_av]",
+
+	"short-hundredweight": "[scwt_av]",
+	"hundredweight":       "[lcwt_av]",
+	"short-ton":           "[ston_av]",
+	"imperial-ton":        "[lton_av]",
+}
+
+// massRec20 records the UN/ECE Recommendation 20 codes of those units of mass
+// which have one
+var massRec20 = map[string]string{
+	"ug":    "MC",
+	"mg":    "MGM",
+	"cg":    "CGM",
+	"dg":    "DGM",
+	bunMass: "GRM",
+	"dag":   "DJ",
+	"hg":    "HGM",
+	"kg":    "KGM",
+
+	"tonne":      "TNE",
+	"kilotonne":  "KTN",
+	"dalton":     "D43",
+	"grain":      "GRN",
+	"troy-ounce": "APZ",
+	"ounce":      "ONZ",
+	"pound":      "LBR",
+	"stone":      "STI",
+
+	"short-hundredweight": "CWA",
+	"hundredweight":       "CWI",
+	"short-ton":           "STN",
+	"imperial-ton":        "LTN",
+}
+
+// massExternalIDs records the identifiers of the units of mass in external
+// schemes (see Unit.ExternalIDs)
+var massExternalIDs = map[string]map[string]string{
+	bunMass: {SchemeQUDT: "GM", SchemeWikidata: "Q41803", SchemeOM: "gram"},
+	"kg": {
+		SchemeQUDT: "KiloGM", SchemeWikidata: "Q11570", SchemeOM: "kilogram",
+	},
+	"tonne": {
+		SchemeQUDT: "TONNE", SchemeWikidata: "Q191118", SchemeOM: "tonne",
+	},
+	"ounce": {
+		SchemeQUDT: "OZ", SchemeWikidata: "Q48013",
+		SchemeOM: "ounce-Avoirdupois",
+	},
+	"pound": {
+		SchemeQUDT: "LB", SchemeWikidata: "Q100995",
+		SchemeOM: "pound-Avoirdupois",
+	},
+}
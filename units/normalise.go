@@ -0,0 +1,196 @@
+package units
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// normEntry records the unit that a normalised name refers to together with
+// the name (or alias) from which the normalised name was generated.
+type normEntry struct {
+	unitID string
+	name   string
+}
+
+// ambiguousNormEntry marks a normalised name which is generated from the
+// names of more than one unit and so cannot be used to find a unit.
+var ambiguousNormEntry = normEntry{}
+
+// perRE matches the word "per" used to form a compound unit name
+var perRE = regexp.MustCompile(`(?i)\s+per\s+`)
+
+// spellingVariants maps alternative spellings to those used in the unit
+// names. The alternative spelling may appear anywhere in a word so, for
+// instance, "kilometer" will be translated to "kilometre"
+//
+//nolint:misspell
+var spellingVariants = strings.NewReplacer(
+	"meter", "metre",
+	"liter", "litre",
+	"gramme", "gram",
+	"deka", "deca",
+)
+
+// minPluralLen is the minimum length of a word that will have a plural
+// suffix removed. This avoids abbreviations such as "ms" being mistaken for
+// plurals.
+const minPluralLen = 4
+
+// minFoldLen is the minimum length of a word that will be lower-cased. The
+// case of shorter words is kept since they are likely to be abbreviations
+// where the case matters; for instance "mg" is a milligram but "Mg" is a
+// megagram (a tonne) and so "MG" should not be taken to mean either.
+const minFoldLen = 4
+
+// singular returns the word with any plural suffix removed. Note that it
+// does not need to give a correct English singular form, only to give the
+// same result for the singular and plural forms of a word.
+func singular(w string) string {
+	if len(w) < minPluralLen {
+		return w
+	}
+
+	switch {
+	case strings.HasSuffix(w, "ies"):
+		return strings.TrimSuffix(w, "ies") + "y"
+	case strings.HasSuffix(w, "ches"),
+		strings.HasSuffix(w, "shes"),
+		strings.HasSuffix(w, "sses"),
+		strings.HasSuffix(w, "xes"):
+		return strings.TrimSuffix(w, "es")
+	case strings.HasSuffix(w, "ss"):
+		return w
+	case strings.HasSuffix(w, "s"):
+		return strings.TrimSuffix(w, "s")
+	}
+
+	return w
+}
+
+// normaliseUnitName returns the normalised form of the unit name. This is
+// used to find units regardless of differences of case, spelling (US or
+// UK), singular or plural form, the use of spaces, hyphens or underscores
+// and the use of "per" or "/" in compound names. Note that the case of
+// short words is kept (see minFoldLen).
+func normaliseUnitName(s string) string {
+	s = perRE.ReplaceAllString(strings.TrimSpace(s), "/")
+
+	var (
+		sb   strings.Builder
+		word strings.Builder
+	)
+
+	flushWord := func() {
+		w := word.String()
+		if utf8.RuneCountInString(w) >= minFoldLen {
+			w = singular(spellingVariants.Replace(strings.ToLower(w)))
+		}
+
+		sb.WriteString(w)
+		word.Reset()
+	}
+
+	for _, r := range s {
+		switch {
+		case unicode.IsLetter(r):
+			word.WriteRune(r)
+		case unicode.IsSpace(r) || r == '-' || r == '_':
+			flushWord()
+		default:
+			flushWord()
+			sb.WriteRune(r)
+		}
+	}
+
+	flushWord()
+
+	return sb.String()
+}
+
+// addNormName adds an entry to the given map of normalised names. If the
+// normalised name is already present for a different unit the entry is
+// marked as ambiguous. If it is already present for the same unit then the
+// unit name is preferred, otherwise the lexically first name is kept so
+// that the choice doesn't depend on the order in which names are added.
+func addNormName(normNames map[string]normEntry, name, uID string) {
+	key := normaliseUnitName(name)
+	if key == "" {
+		return
+	}
+
+	if ne, ok := normNames[key]; ok {
+		switch {
+		case ne.unitID != uID:
+			normNames[key] = ambiguousNormEntry
+		case ne.name == uID:
+		case name == uID || name < ne.name:
+			normNames[key] = normEntry{unitID: uID, name: name}
+		}
+
+		return
+	}
+
+	normNames[key] = normEntry{unitID: uID, name: name}
+}
+
+// populateNormalisedNames sets up the tables of normalised names for the
+// Family. It should be called after the unit aliases have been populated.
+//
+// There are three tables, in order of priority: the first holds the
+// normalised forms of the unit names and their aliases, the second holds
+// the normalised forms of the full names of the units (singular and plural)
+// and the third holds the normalised forms of the unit abbreviations. A name
+// which is ambiguous in one table is not looked for in the later ones; a
+// later table could otherwise silently choose one of the units it might
+// refer to.
+func (f *Family) populateNormalisedNames() {
+	ids := map[string]normEntry{}
+	names := map[string]normEntry{}
+	abbrevs := map[string]normEntry{}
+
+	for uID, u := range f.altUnits {
+		addNormName(ids, uID, uID)
+		addNormName(names, u.name, uID)
+		addNormName(names, u.namePlural, uID)
+		addNormName(abbrevs, u.abbrev, uID)
+	}
+
+	for alias, uID := range f.unitAliases {
+		addNormName(ids, alias, uID)
+	}
+
+	f.normNames = []map[string]normEntry{ids, names, abbrevs}
+}
+
+// getUnitNormalised looks for the unit using the normalised form of the
+// name. It returns false if no unit can be unambiguously found.
+func (f *Family) getUnitNormalised(uName string) (Unit, bool) {
+	key := normaliseUnitName(uName)
+
+	for _, normNames := range f.normNames {
+		ne, ok := normNames[key]
+		if !ok {
+			continue
+		}
+
+		if ne == ambiguousNormEntry {
+			return Unit{}, false
+		}
+
+		u, ok := f.altUnits[ne.unitID]
+		if !ok {
+			return Unit{}, false
+		}
+
+		u.id = ne.unitID
+		if ne.name != ne.unitID {
+			u.alias = ne.name
+		}
+
+		return u, true
+	}
+
+	return Unit{}, false
+}
@@ -0,0 +1,188 @@
+package units
+
+import (
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestNormaliseUnitName(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		name   string
+		expStr string
+	}{
+		{ID: testhelper.MkID("lower case"), name: "metre", expStr: "metre"},
+		{ID: testhelper.MkID("mixed case"), name: "Metre", expStr: "metre"},
+		{ID: testhelper.MkID("US spelling"), name: "meter", expStr: "metre"},
+		{ID: testhelper.MkID("plural"), name: "metres", expStr: "metre"},
+		{ID: testhelper.MkID("plural -es"), name: "inches", expStr: "inch"},
+		{ID: testhelper.MkID("plural -ies"), name: "centuries", expStr: "century"},
+		{ID: testhelper.MkID("short word"), name: "ms", expStr: "ms"},
+		{ID: testhelper.MkID("short word, case"), name: "MG", expStr: "MG"},
+		{
+			ID:     testhelper.MkID("case of short words kept"),
+			name:   "Kilometers per H",
+			expStr: "kilometre/H",
+		},
+		{
+			ID:     testhelper.MkID("spaces, hyphens and underscores"),
+			name:   " Nautical-Mile_ (US)",
+			expStr: "nauticalmile(US)",
+		},
+		{
+			ID:     testhelper.MkID("per"),
+			name:   "kilometers per hour",
+			expStr: "kilometre/hour",
+		},
+		{
+			ID:     testhelper.MkID("slash"),
+			name:   "kilometres/hours",
+			expStr: "kilometre/hour",
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "normalised name",
+			normaliseUnitName(tc.name), tc.expStr)
+	}
+}
+
+func TestGetUnitNormalised(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		fName    string
+		uName    string
+		expID    string
+		strict   bool
+		expAlias string
+	}{
+		{
+			ID:       testhelper.MkID("US spelling, plural"),
+			fName:    Volume,
+			uName:    "Liters",
+			expID:    "litre",
+			expAlias: "",
+		},
+		{
+			ID:       testhelper.MkID("per"),
+			fName:    Velocity,
+			uName:    "Kilometers per Hour",
+			expID:    "kilometre/hour",
+			expAlias: "",
+		},
+		{
+			ID:       testhelper.MkID("full name, hyphenated"),
+			fName:    Temperature,
+			uName:    "Degrees-Fahrenheit",
+			expID:    "F",
+			expAlias: "degree Fahrenheit",
+		},
+		{
+			ID:       testhelper.MkID("abbreviation"),
+			fName:    Area,
+			uName:    "m²",
+			expID:    "square metre",
+			expAlias: "m²",
+		},
+		{
+			ID:       testhelper.MkID("abbreviation shared by several units"),
+			fName:    Distance,
+			uName:    "ft",
+			expID:    "foot",
+			expAlias: "ft",
+		},
+		{
+			ID:    testhelper.MkID("ambiguous"),
+			fName: Distance,
+			uName: "MM",
+			ExpErr: testhelper.MkExpErr(
+				`there is no unit of distance called "MM"`),
+		},
+		{
+			ID:    testhelper.MkID("case matters: MG"),
+			fName: Mass,
+			uName: "MG",
+			ExpErr: testhelper.MkExpErr(
+				`there is no unit of mass called "MG"`),
+		},
+		{
+			ID:    testhelper.MkID("case matters: PM"),
+			fName: Distance,
+			uName: "PM",
+			ExpErr: testhelper.MkExpErr(
+				`there is no unit of distance called "PM"`),
+		},
+		{
+			ID:    testhelper.MkID("case matters: mJ"),
+			fName: Energy,
+			uName: "mJ",
+			expID: "mJ",
+		},
+		{
+			ID:    testhelper.MkID("case matters: mj"),
+			fName: Energy,
+			uName: "mj",
+			ExpErr: testhelper.MkExpErr(
+				`there is no unit of energy called "mj"`),
+		},
+		{
+			ID:    testhelper.MkID("ambiguous, longer name"),
+			fName: Time,
+			uName: "MSEC",
+			ExpErr: testhelper.MkExpErr(
+				`there is no unit of time called "MSEC"`),
+		},
+		{
+			ID:     testhelper.MkID("strict"),
+			fName:  Volume,
+			uName:  "Liters",
+			strict: true,
+			ExpErr: testhelper.MkExpErr(
+				`there is no unit of volume called "Liters"`),
+		},
+	}
+
+	for _, tc := range testCases {
+		var (
+			u   Unit
+			err error
+		)
+
+		if tc.strict {
+			u, err = GetStrict(tc.fName, tc.uName)
+		} else {
+			u, err = Get(tc.fName, tc.uName)
+		}
+
+		if !testhelper.CheckExpErr(t, err, tc) || err != nil {
+			continue
+		}
+
+		testhelper.DiffString(t, tc.IDStr(), "ID", u.ID(), tc.expID)
+		testhelper.DiffString(t, tc.IDStr(), "AliasName",
+			u.AliasName(), tc.expAlias)
+	}
+}
+
+func TestGetUnitNormalisedAmbiguous(t *testing.T) {
+	f := &Family{
+		description: "unit of test",
+		altUnits: map[string]Unit{
+			"Abcd": {convFactor: 1, name: "abcd", namePlural: "abcds"},
+			"abcd": {convFactor: 2, name: "efgh", namePlural: "efghs"},
+		},
+		unitAliases: map[string]string{},
+	}
+	f.populateNormalisedNames()
+
+	// "ABCD" is ambiguous between the unit IDs and so must not be found
+	// through the unambiguous full name of the unit "Abcd"
+	_, ok := f.getUnitNormalised("ABCD")
+	testhelper.DiffBool(t, "ABCD", "found", ok, false)
+
+	u, ok := f.getUnitNormalised("EFGHS")
+	testhelper.DiffBool(t, "EFGHS", "found", ok, true)
+	testhelper.DiffString(t, "EFGHS", "ID", u.ID(), "abcd")
+}
@@ -0,0 +1,190 @@
+package units
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ParsedValUnit records a ValUnit which has been parsed from a string
+// together with the number of significant figures given in the string. This
+// allows the precision of the original value to be kept when it is
+// converted to other units.
+type ParsedValUnit struct {
+	ValUnit
+	SigFigs int
+}
+
+// valUnitRE matches a number followed by a unit name. The number may have a
+// sign, a decimal point and an exponent.
+var valUnitRE = regexp.MustCompile(
+	`^\s*([-+]?(?:\d+\.?\d*|\.\d+)(?:[eE][-+]?\d+)?)\s*(.*?)\s*$`)
+
+// countSigFigs returns the number of significant figures in the number,
+// which should be a valid decimal number. Leading zeros are never
+// significant and trailing zeros in a number without a decimal point are
+// taken to be not significant. A zero value has at least one significant
+// figure.
+func countSigFigs(num string) int {
+	num = strings.TrimLeft(num, "+-")
+	if i := strings.IndexAny(num, "eE"); i >= 0 {
+		num = num[:i]
+	}
+
+	hasPoint := strings.Contains(num, ".")
+	digits := strings.TrimLeft(strings.Replace(num, ".", "", 1), "0")
+
+	if digits == "" {
+		_, frac, _ := strings.Cut(num, ".")
+		return max(len(frac), 1)
+	}
+
+	if !hasPoint {
+		digits = strings.TrimRight(digits, "0")
+	}
+
+	return max(len(digits), 1)
+}
+
+// ParseValUnit parses the string as a number followed by the name of a unit
+// in the Family, for instance "5.0 ft". The unit name is found as for the
+// GetUnit method. The number of significant figures in the number is
+// recorded in the returned value. A non-nil error is returned if the string
+// cannot be parsed or the unit is not found.
+func (f *Family) ParseValUnit(s string) (ParsedValUnit, error) {
+	pvu, err := f.parseValUnit(s)
+
+	return pvu, f.addSuggestions(err)
+}
+
+// parseValUnit parses the string as described for ParseValUnit. If the unit
+// is not found the error will not have any suggestions.
+func (f *Family) parseValUnit(s string) (ParsedValUnit, error) {
+	parts := valUnitRE.FindStringSubmatch(s)
+	if parts == nil {
+		return ParsedValUnit{},
+			fmt.Errorf("%q does not start with a number", s)
+	}
+
+	num, uName := parts[1], parts[2]
+	if uName == "" {
+		return ParsedValUnit{},
+			fmt.Errorf("%q has no %s", s, f.description)
+	}
+
+	v, err := strconv.ParseFloat(num, 64)
+	if err != nil {
+		return ParsedValUnit{}, fmt.Errorf("bad number in %q: %w", s, err)
+	}
+
+	u, err := f.getUnit(uName)
+	if err != nil {
+		return ParsedValUnit{}, err
+	}
+
+	return ParsedValUnit{
+		ValUnit: ValUnit{V: v, U: u},
+		SigFigs: countSigFigs(num),
+	}, nil
+}
+
+// ParseValUnit parses the string as a value in the named Family. See the
+// Family.ParseValUnit method for details.
+func ParseValUnit(fName, s string) (ParsedValUnit, error) {
+	f, err := GetFamily(fName)
+	if err != nil {
+		return ParsedValUnit{}, err
+	}
+
+	return f.ParseValUnit(s)
+}
+
+// Convert converts the value to the new units, keeping the number of
+// significant figures. See ValUnit.Convert for details.
+func (pvu ParsedValUnit) Convert(u Unit) (ParsedValUnit, error) {
+	vu, err := pvu.ValUnit.Convert(u)
+
+	return ParsedValUnit{ValUnit: vu, SigFigs: pvu.SigFigs}, err
+}
+
+// ConvertOrPanic will call Convert and if the error returned is not nil it
+// will panic, otherwise it will return the ParsedValUnit value
+func (pvu ParsedValUnit) ConvertOrPanic(u Unit) ParsedValUnit {
+	convertedVal, err := pvu.Convert(u)
+	if err != nil {
+		panic(err)
+	}
+
+	return convertedVal
+}
+
+// Rounded returns the ValUnit with the value rounded to the number of
+// significant figures. If the number of significant figures is not set the
+// value is not changed.
+func (pvu ParsedValUnit) Rounded() ValUnit {
+	if pvu.SigFigs < 1 || pvu.V == 0 ||
+		math.IsInf(pvu.V, 0) || math.IsNaN(pvu.V) {
+		return pvu.ValUnit
+	}
+
+	v, err := strconv.ParseFloat(
+		strconv.FormatFloat(pvu.V, 'e', pvu.SigFigs-1, 64), 64)
+	if err != nil {
+		return pvu.ValUnit
+	}
+
+	return ValUnit{V: v, U: pvu.U}
+}
+
+// plainFormatter is used to generate the String form of a ParsedValUnit. It
+// is like the English Formatter but without any grouping of digits so as to
+// match the ValUnit.String method.
+var plainFormatter = &Formatter{
+	locale: &Locale{
+		Name:       "plain",
+		DecimalSep: ".",
+		PluralRule: EnglishPluralRule,
+	},
+	precision: dfltFmtPrecision,
+}
+
+// String returns a string form of the ParsedValUnit showing the value to
+// the number of significant figures.
+func (pvu ParsedValUnit) String() string {
+	return plainFormatter.FormatParsed(pvu)
+}
+
+// FormatParsed returns the ParsedValUnit formatted according to the
+// Formatter settings except that the value is shown to the number of
+// significant figures recorded in the ParsedValUnit (if set).
+func (f *Formatter) FormatParsed(pvu ParsedValUnit) string {
+	if pvu.SigFigs < 1 {
+		return f.Format(pvu.ValUnit)
+	}
+
+	return f.withSigFigs(pvu.SigFigs).Format(pvu.ValUnit)
+}
+
+// Format provides a custom formatter for a ParsedValUnit. The 's' and 'q'
+// verbs show the value to the number of significant figures (see the String
+// method) and the 'l' verb does the same using the DefaultFormatter. Other
+// verbs are as for the ValUnit.
+func (pvu ParsedValUnit) Format(f fmt.State, verb rune) {
+	fmtStrStart, _ := makeFmtStart(f)
+
+	switch verb {
+	case 's', 'q':
+		fmt.Fprintf(f, fmtStrStart+string(verb), pvu.String())
+	case 'l':
+		if _, ok := f.Precision(); ok {
+			pvu.ValUnit.Format(f, verb)
+			return
+		}
+
+		fmt.Fprintf(f, fmtStrStart+"s", DefaultFormatter().FormatParsed(pvu))
+	default:
+		pvu.ValUnit.Format(f, verb)
+	}
+}
@@ -0,0 +1,218 @@
+package units
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestCountSigFigs(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		num    string
+		expVal int
+	}{
+		{ID: testhelper.MkID("integer"), num: "123", expVal: 3},
+		{ID: testhelper.MkID("trailing zeros"), num: "1500", expVal: 2},
+		{ID: testhelper.MkID("trailing point"), num: "1500.", expVal: 4},
+		{ID: testhelper.MkID("trailing fraction zero"), num: "5.0", expVal: 2},
+		{ID: testhelper.MkID("leading zeros"), num: "0.00120", expVal: 3},
+		{ID: testhelper.MkID("exponent"), num: "-1.20e4", expVal: 3},
+		{ID: testhelper.MkID("zero"), num: "0", expVal: 1},
+		{ID: testhelper.MkID("zero, with fraction"), num: "0.00", expVal: 2},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffInt(t, tc.IDStr(), "significant figures",
+			countSigFigs(tc.num), tc.expVal)
+	}
+}
+
+func TestParseValUnit(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		family     string
+		s          string
+		expVal     float64
+		expUnit    string
+		expSigFigs int
+	}{
+		{
+			ID:         testhelper.MkID("abbreviation"),
+			family:     Distance,
+			s:          "5.0 ft",
+			expVal:     5,
+			expUnit:    "foot",
+			expSigFigs: 2,
+		},
+		{
+			ID:         testhelper.MkID("no space, exponent"),
+			family:     Distance,
+			s:          " -1.5e3km ",
+			expVal:     -1500,
+			expUnit:    "km",
+			expSigFigs: 2,
+		},
+		{
+			ID:         testhelper.MkID("normalised name"),
+			family:     Mass,
+			s:          "12 Kilograms",
+			expVal:     12,
+			expUnit:    "kg",
+			expSigFigs: 2,
+		},
+		{
+			ID:     testhelper.MkID("no number"),
+			family: Distance,
+			s:      "ft",
+			ExpErr: testhelper.MkExpErr(`"ft" does not start with a number`),
+		},
+		{
+			ID:     testhelper.MkID("no unit"),
+			family: Distance,
+			s:      "12",
+			ExpErr: testhelper.MkExpErr(`"12" has no unit of distance`),
+		},
+		{
+			ID:     testhelper.MkID("bad unit"),
+			family: Distance,
+			s:      "12 kg",
+			ExpErr: testhelper.MkExpErr(
+				`there is no unit of distance called "kg"`),
+		},
+		{
+			ID:     testhelper.MkID("bad family"),
+			family: "nonesuch",
+			s:      "12 kg",
+			ExpErr: testhelper.MkExpErr(
+				`there is no unit family called "nonesuch"`),
+		},
+	}
+
+	for _, tc := range testCases {
+		pvu, err := ParseValUnit(tc.family, tc.s)
+		if !testhelper.CheckExpErr(t, err, tc) || err != nil {
+			continue
+		}
+
+		testhelper.DiffFloat(t, tc.IDStr(), "value", pvu.V, tc.expVal, 0)
+		testhelper.DiffString(t, tc.IDStr(), "unit", pvu.U.ID(), tc.expUnit)
+		testhelper.DiffInt(t, tc.IDStr(), "significant figures",
+			pvu.SigFigs, tc.expSigFigs)
+	}
+}
+
+func TestParsedValUnitConvert(t *testing.T) {
+	pvu, err := ParseValUnit(Distance, "5.0 ft")
+	if err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+
+	cvu := pvu.ConvertOrPanic(GetOrPanic(Distance, "metre"))
+
+	testhelper.DiffString(t, "5.0 ft in metres", "String",
+		cvu.String(), "1.5 metres")
+	testhelper.DiffString(t, "5.0 ft in metres", "%s",
+		fmt.Sprintf("%s", cvu), "1.5 metres")
+	testhelper.DiffString(t, "5.0 ft in metres", "%.3l",
+		fmt.Sprintf("%.3l", cvu), "1.524 metres")
+	testhelper.DiffString(t, "5.0 ft in metres", "ValUnit.String",
+		cvu.ValUnit.String(), "1.524 metres")
+	testhelper.DiffFloat(t, "5.0 ft in metres", "Rounded",
+		cvu.Rounded().V, 1.5, 0)
+
+	_, err = pvu.Convert(GetOrPanic(Mass, "kg"))
+	testhelper.CheckExpErr(t, err, struct {
+		testhelper.ID
+		testhelper.ExpErr
+	}{
+		ID:     testhelper.MkID("mismatched families"),
+		ExpErr: testhelper.MkExpErr("mismatched unit families"),
+	})
+}
+
+func TestFormatterSigFigs(t *testing.T) {
+	metre := GetOrPanic(Distance, "metre")
+
+	testCases := []struct {
+		testhelper.ID
+		opts   []FormatterOpt
+		v      float64
+		expStr string
+	}{
+		{
+			ID:     testhelper.MkID("trailing zero kept"),
+			opts:   []FormatterOpt{FmtOptSigFigs(3)},
+			v:      1.5,
+			expStr: "1.50 metres",
+		},
+		{
+			ID:     testhelper.MkID("rounded integer"),
+			opts:   []FormatterOpt{FmtOptSigFigs(3)},
+			v:      1234.5,
+			expStr: "1,230 metres",
+		},
+		{
+			ID:     testhelper.MkID("small value"),
+			opts:   []FormatterOpt{FmtOptSigFigs(3)},
+			v:      0.000012345,
+			expStr: "0.0000123 metres",
+		},
+		{
+			ID:     testhelper.MkID("rounded up"),
+			opts:   []FormatterOpt{FmtOptSigFigs(3)},
+			v:      9.996,
+			expStr: "10.0 metres",
+		},
+		{
+			ID:     testhelper.MkID("large value"),
+			opts:   []FormatterOpt{FmtOptSigFigs(3)},
+			v:      1e25,
+			expStr: "1.00e+25 metres",
+		},
+		{
+			ID:     testhelper.MkID("zero"),
+			opts:   []FormatterOpt{FmtOptSigFigs(2)},
+			v:      0,
+			expStr: "0.0 metres",
+		},
+		{
+			ID: testhelper.MkID("engineering"),
+			opts: []FormatterOpt{
+				FmtOptSigFigs(3),
+				FmtOptEngineering(EngSIPrefix),
+				FmtOptAbbrev(),
+			},
+			v:      999.7,
+			expStr: "1.00 km",
+		},
+		{
+			ID: testhelper.MkID("engineering, small value"),
+			opts: []FormatterOpt{
+				FmtOptSigFigs(2),
+				FmtOptEngineering(EngE),
+				FmtOptAbbrev(),
+			},
+			v:      0.0000012,
+			expStr: "1.2e-6 m",
+		},
+	}
+
+	for _, tc := range testCases {
+		f := NewFormatterOrPanic(tc.opts...)
+		testhelper.DiffString(t, tc.IDStr(), "formatted value",
+			f.Format(ValUnit{V: tc.v, U: metre}), tc.expStr)
+	}
+
+	_, err := NewFormatter(FmtOptSigFigs(0))
+	testhelper.CheckExpErr(t, err, struct {
+		testhelper.ID
+		testhelper.ExpErr
+	}{
+		ID: testhelper.MkID("bad significant figures"),
+		ExpErr: testhelper.MkExpErr(
+			"the number of significant figures must be at least 1"),
+	})
+}
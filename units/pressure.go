@@ -352,3 +352,80 @@ var pressureNames = map[string]Unit{
 		"", "",
 	},
 }
+
+// pressureProvenance records the source of the conversion factor for each
+// unit of pressure
+var pressureProvenance = map[string]Provenance{
+	bunPressure: provSI,
+	"mPa":       provSI,
+	"cPa":       provSI,
+	"dPa":       provSI,
+	"hPa":       provSI,
+	"kPa":       provSI,
+	"MPa":       provSI,
+	"GPa":       provSI,
+	"TPa":       provSI,
+	"PPa":       provSI,
+
+	"standard atmosphere": provNISTSP811,
+	"bar":                 provNISTSP811,
+	"millibar":            provNISTSP811,
+	"centibar":            provNISTSP811,
+	"decibar":             provNISTSP811,
+	"kilobar":             provNISTSP811,
+	"megabar":             provNISTSP811,
+
+	"psi": {
+		Kind: ConvDerived, Source: SrcNISTSP811, SigDigits: 10,
+	},
+	"kpsi": {
+		Kind: ConvDerived, Source: SrcNISTSP811, SigDigits: 10,
+	},
+	"Mpsi": {
+		Kind: ConvDerived, Source: SrcNISTSP811, SigDigits: 10,
+	},
+
+	"mmHg": provNISTSP811,
+	"Torr": provNISTSP811,
+
+	"barye":      provNISTSP811,
+	"millibarye": provNISTSP811,
+	"kilobarye":  provNISTSP811,
+}
+
+// pressureUCUM records the UCUM codes of those units of pressure which have
+// one
+var pressureUCUM = map[string]string{
+	"mPa":       "mPa",
+	"cPa":       "cPa",
+	"dPa":       "dPa",
+	bunPressure: "Pa",
+	"hPa":       "hPa",
+	"kPa":       "kPa",
+	"MPa":       "MPa",
+	"GPa":       "GPa",
+	"TPa":       "TPa",
+	"PPa":       "PPa",
+
+	"millibar":            "mbar",
+	"centibar":            "cbar",
+	"decibar":             "dbar",
+	"bar":                 "bar",
+	"kilobar":             "kbar",
+	"megabar":             "Mbar",
+	"standard atmosphere": "atm",
+	"mmHg":                "mm[Hg]",
+	"psi":                 "[psi]",
+}
+
+// pressureExternalIDs records the identifiers of the units of pressure in
+// external schemes (see Unit.ExternalIDs)
+var pressureExternalIDs = map[string]map[string]string{
+	bunPressure: {
+		SchemeQUDT: "PA", SchemeWikidata: "Q44395", SchemeOM: "pascal",
+	},
+	"bar": {SchemeQUDT: "BAR", SchemeWikidata: "Q103510", SchemeOM: "bar"},
+	"standard atmosphere": {
+		SchemeQUDT: "ATM", SchemeWikidata: "Q177974",
+	},
+}
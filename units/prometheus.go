@@ -0,0 +1,198 @@
+package units
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// promBaseUnit records the unit recommended for a Family by the Prometheus
+// and OpenMetrics naming conventions and the suffix used for it in metric
+// names
+type promBaseUnit struct {
+	id       string
+	plural   string
+	singular string
+}
+
+// promBaseUnits maps the built-in Families to the units recommended for
+// them in metric names. Note that kelvin is used for temperature rather
+// than degrees Celsius so that values are never negative and can be
+// summed.
+var promBaseUnits = map[*Family]promBaseUnit{
+	numericFamily:     {id: bunNumeric, plural: "ratio", singular: "ratio"},
+	timeFamily:        {id: bunTime, plural: "seconds", singular: "second"},
+	dataFamily:        {id: bunData, plural: "bytes", singular: "byte"},
+	distanceFamily:    {id: bunDistance, plural: "meters", singular: "meter"},
+	massFamily:        {id: bunMass, plural: "grams", singular: "gram"},
+	temperatureFamily: {id: "K", plural: "kelvin", singular: "kelvin"},
+	angleFamily:       {id: bunAngle, plural: "radians", singular: "radian"},
+	energyFamily:      {id: bunEnergy, plural: "joules", singular: "joule"},
+	pressureFamily:    {id: bunPressure, plural: "pascals", singular: "pascal"},
+	areaFamily: {
+		id: bunArea, plural: "square_meters", singular: "square_meter",
+	},
+	volumeFamily: {
+		id: bunVolume, plural: "cubic_meters", singular: "cubic_meter",
+	},
+	velocityFamily: {
+		id: bunVelocity, plural: "meters_per_second",
+		singular: "meter_per_second",
+	},
+}
+
+// promMetricTypeSuffixes are the suffixes which may follow the unit in the
+// name of a metric or of one of its samples
+var promMetricTypeSuffixes = []string{
+	"_total", "_created", "_bucket", "_count", "_sum",
+}
+
+// promBaseUnitFor returns the details of the unit recommended for the
+// Family in metric names. For a rate Family these are made from those of
+// its numerator and denominator, for instance "bytes_per_second".
+func promBaseUnitFor(f *Family) (promBaseUnit, error) {
+	if pbu, ok := promBaseUnits[f]; ok {
+		return pbu, nil
+	}
+
+	if f.rate != nil {
+		num, err := promBaseUnitFor(f.rate.num)
+		if err != nil {
+			return promBaseUnit{}, err
+		}
+
+		den, err := promBaseUnitFor(f.rate.den)
+		if err != nil {
+			return promBaseUnit{}, err
+		}
+
+		return promBaseUnit{
+			id:       num.id + rateSep + den.id,
+			plural:   num.plural + "_per_" + den.singular,
+			singular: num.singular + "_per_" + den.singular,
+		}, nil
+	}
+
+	return promBaseUnit{},
+		fmt.Errorf("there is no Prometheus unit for the %s family", f.name)
+}
+
+// PrometheusUnit returns the unit recommended for values of the Family in
+// Prometheus (and OpenMetrics) metrics, together with the suffix which
+// should end the name of the metric, for instance the second and "seconds"
+// for the time Family. The suffix does not include the leading underscore.
+func (f *Family) PrometheusUnit() (Unit, string, error) {
+	pbu, err := promBaseUnitFor(f)
+	if err != nil {
+		return Unit{}, "", err
+	}
+
+	u, err := f.GetUnitStrict(pbu.id)
+	if err != nil {
+		return Unit{}, "", err
+	}
+
+	return u, pbu.plural, nil
+}
+
+// Prometheus returns the value of the ValUnit converted to the unit
+// recommended for its Family in Prometheus metrics (see
+// Family.PrometheusUnit) and the suffix which should end the name of the
+// metric, for instance 1.5 and "seconds" for 1500 milliseconds.
+func (v ValUnit) Prometheus() (float64, string, error) {
+	if v.U.f == nil {
+		return 0, "", fmt.Errorf("the unit %q has no family", v.U.id)
+	}
+
+	u, suffix, err := v.U.f.PrometheusUnit()
+	if err != nil {
+		return 0, "", err
+	}
+
+	pv, err := v.Convert(u)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return pv.V, suffix, nil
+}
+
+// promSuffixRE matches the unit names which can be used as metric name
+// suffixes
+var promSuffixRE = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// promSuffixReplacer converts a unit name to the form used in metric names
+var promSuffixReplacer = strings.NewReplacer(
+	" ", "_",
+	"-", "_",
+	rateSep, "_per_",
+	"metre", "meter",
+	"litre", "liter",
+)
+
+// promSuffixes returns the metric name suffixes which might be used for
+// the Unit, made from its names and aliases
+func promSuffixes(u Unit) []string {
+	suffixes := []string{}
+
+	names := []string{u.namePlural, u.name}
+	for alias := range u.aliases {
+		names = append(names, alias)
+	}
+
+	for _, name := range names {
+		s := promSuffixReplacer.Replace(strings.ToLower(name))
+		if promSuffixRE.MatchString(s) {
+			suffixes = append(suffixes, s)
+		}
+	}
+
+	return suffixes
+}
+
+// CheckPrometheusName checks that the metric name ends with the suffix for
+// the unit recommended for the Family (see PrometheusUnit). Any suffix for
+// the type of the metric or sample, such as "_total" or "_bucket", is
+// ignored. A non-nil error is returned if the name does not end with the
+// suffix; if it ends instead with the suffix for some other unit of the
+// Family, such as "_milliseconds" or "_kibibytes", the error says so.
+func (f *Family) CheckPrometheusName(name string) error {
+	pbu, err := promBaseUnitFor(f)
+	if err != nil {
+		return err
+	}
+
+	stem := name
+	for _, s := range promMetricTypeSuffixes {
+		stem = strings.TrimSuffix(stem, s)
+	}
+
+	if strings.HasSuffix(stem, "_"+pbu.plural) {
+		return nil
+	}
+
+	longest := ""
+
+	for _, u := range f.GetUnits() {
+		for _, s := range promSuffixes(u) {
+			if len(s) > len(longest) && strings.HasSuffix(stem, "_"+s) {
+				longest = s
+			}
+		}
+	}
+
+	if longest != "" {
+		bu, err := f.GetUnitStrict(pbu.id)
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf(
+			"the metric %q has the unit suffix %q,"+
+				" values should be in %s with the suffix %q",
+			name, "_"+longest, bu.namePlural, "_"+pbu.plural)
+	}
+
+	return fmt.Errorf("the metric %q does not end with the unit suffix %q",
+		name, "_"+pbu.plural)
+}
@@ -0,0 +1,144 @@
+package units
+
+import (
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestValUnitPrometheus(t *testing.T) {
+	const epsilon = 1e-9
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		vu        ValUnit
+		expVal    float64
+		expSuffix string
+	}{
+		{
+			ID:        testhelper.MkID("time"),
+			vu:        ValUnit{V: 1500, U: timeFamily.GetUnitOrPanic("msec")},
+			expVal:    1.5,
+			expSuffix: "seconds",
+		},
+		{
+			ID:        testhelper.MkID("data"),
+			vu:        ValUnit{V: 2, U: dataFamily.GetUnitOrPanic("KiB")},
+			expVal:    2048,
+			expSuffix: "bytes",
+		},
+		{
+			ID:        testhelper.MkID("temperature"),
+			vu:        ValUnit{V: 20, U: temperatureFamily.GetUnitOrPanic("C")},
+			expVal:    293.15,
+			expSuffix: "kelvin",
+		},
+		{
+			ID:        testhelper.MkID("dimensionless"),
+			vu:        ValUnit{V: 3, U: numericFamily.GetUnitOrPanic("dozen")},
+			expVal:    36,
+			expSuffix: "ratio",
+		},
+		{
+			ID: testhelper.MkID("rate"),
+			vu: ValUnit{
+				V: 60,
+				U: GetOrPanic("data/time", "MB/minute"),
+			},
+			expVal:    1e6,
+			expSuffix: "bytes_per_second",
+		},
+		{
+			ID:     testhelper.MkID("no family"),
+			vu:     ValUnit{V: 1},
+			ExpErr: testhelper.MkExpErr(`the unit "" has no family`),
+		},
+	}
+
+	for _, tc := range testCases {
+		v, suffix, err := tc.vu.Prometheus()
+		if testhelper.CheckExpErr(t, err, tc) && err == nil {
+			testhelper.DiffFloat(t, tc.IDStr(), "value", v, tc.expVal, epsilon)
+			testhelper.DiffString(t, tc.IDStr(), "suffix", suffix, tc.expSuffix)
+		}
+	}
+}
+
+func TestCheckPrometheusName(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		f    *Family
+		name string
+	}{
+		{
+			ID:   testhelper.MkID("good time"),
+			f:    timeFamily,
+			name: "http_request_duration_seconds",
+		},
+		{
+			ID:   testhelper.MkID("good counter"),
+			f:    dataFamily,
+			name: "network_received_bytes_total",
+		},
+		{
+			ID:   testhelper.MkID("good histogram bucket"),
+			f:    timeFamily,
+			name: "rpc_latency_seconds_bucket",
+		},
+		{
+			ID:   testhelper.MkID("good rate"),
+			f:    GetFamilyOrPanic("data/time"),
+			name: "disk_write_bytes_per_second",
+		},
+		{
+			ID:   testhelper.MkID("non-base time"),
+			f:    timeFamily,
+			name: "gc_pause_milliseconds",
+			ExpErr: testhelper.MkExpErr(
+				`the metric "gc_pause_milliseconds"` +
+					` has the unit suffix "_milliseconds",` +
+					` values should be in seconds with the suffix "_seconds"`),
+		},
+		{
+			ID:   testhelper.MkID("non-base data counter"),
+			f:    dataFamily,
+			name: "cache_kibibytes_total",
+			ExpErr: testhelper.MkExpErr(
+				`the metric "cache_kibibytes_total"` +
+					` has the unit suffix "_kibibytes",` +
+					` values should be in bytes with the suffix "_bytes"`),
+		},
+		{
+			ID:   testhelper.MkID("non-base distance"),
+			f:    distanceFamily,
+			name: "cable_length_kilometers",
+			ExpErr: testhelper.MkExpErr(
+				`the metric "cable_length_kilometers"` +
+					` has the unit suffix "_kilometers",` +
+					` values should be in metres with the suffix "_meters"`),
+		},
+		{
+			ID:   testhelper.MkID("Celsius"),
+			f:    temperatureFamily,
+			name: "cpu_temperature_celsius",
+			ExpErr: testhelper.MkExpErr(
+				`the metric "cpu_temperature_celsius"` +
+					` has the unit suffix "_celsius",` +
+					` values should be in kelvin with the suffix "_kelvin"`),
+		},
+		{
+			ID:   testhelper.MkID("no suffix"),
+			f:    timeFamily,
+			name: "uptime",
+			ExpErr: testhelper.MkExpErr(
+				`the metric "uptime" does not end with the unit suffix "_seconds"`),
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.f.CheckPrometheusName(tc.name)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
@@ -0,0 +1,136 @@
+package units
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ConvKind describes the nature of the conversion factor of a Unit: whether
+// it is exact by definition, derived from other values, the result of a
+// physical measurement or simply a convention.
+type ConvKind int
+
+// These ConvKind values describe how a conversion factor was arrived at.
+const (
+	// ConvUnknown means that nothing is recorded about the conversion factor
+	ConvUnknown ConvKind = iota
+	// ConvExact means that the conversion factor is exact by definition
+	ConvExact
+	// ConvDerived means that the conversion factor is calculated from other
+	// values at least one of which is not exact
+	ConvDerived
+	// ConvMeasured means that the conversion factor is the result of a
+	// physical measurement and is subject to revision
+	ConvMeasured
+	// ConvConventional means that the conversion factor is one agreed value
+	// among several in use or is an approximation adopted by convention
+	ConvConventional
+)
+
+var convKindNames = map[ConvKind]string{
+	ConvUnknown:      "unknown",
+	ConvExact:        "exact",
+	ConvDerived:      "derived",
+	ConvMeasured:     "measured",
+	ConvConventional: "conventional",
+}
+
+// String returns the name of the ConvKind
+func (ck ConvKind) String() string {
+	if s, ok := convKindNames[ck]; ok {
+		return s
+	}
+
+	return "ConvKind(" + strconv.Itoa(int(ck)) + ")"
+}
+
+// These source constants name the standards and other references from which
+// the conversion factors are taken.
+const (
+	SrcSIBrochure     = "BIPM SI Brochure (9th edition, 2019)"
+	SrcNISTSP811      = "NIST SP 811 (2008)"
+	SrcNISTHB44       = "NIST Handbook 44"
+	SrcISO80000       = "ISO/IEC 80000"
+	SrcWMA1985        = "Weights and Measures Act 1985"
+	SrcIntlYardPound  = "International Yard and Pound Agreement (1959)"
+	SrcIHC1929        = "International Hydrographic Conference (1929)"
+	SrcIAU2012        = "IAU 2012 Resolution B2"
+	SrcIAU2015        = "IAU 2015 Resolutions B2 and B3"
+	SrcCODATA2022     = "CODATA 2022"
+	SrcISO31          = "ISO 31-4"
+	SrcISO668         = "ISO 668"
+	SrcISO8601        = "ISO 8601"
+	SrcGregorian      = "Gregorian calendar (1582)"
+	SrcAstroAlmanac   = "The Astronomical Almanac"
+	SrcNASAFactSheet  = "NASA Planetary Fact Sheet"
+	SrcFrenchMetric   = "French law of 18 Germinal, Year III (1795)"
+	SrcUSMendenhall   = "US Mendenhall Order (1893)"
+	SrcEUWineBottles  = "EU Directive 2007/45/EC"
+	SrcWorldAthletics = "World Athletics"
+	SrcHistorical     = "historical usage"
+	SrcCommonUsage    = "common usage"
+	SrcTestData       = "test data"
+)
+
+// Provenance records where the conversion factor of a Unit comes from and
+// how far it can be trusted.
+//
+// SigDigits gives the number of significant digits to which the conversion
+// factor is known; it is zero if the factor has no such limit (typically
+// because it is exact by definition or by convention).
+type Provenance struct {
+	Kind      ConvKind
+	Source    string
+	SigDigits int
+}
+
+// String returns a description of the Provenance suitable for an audit
+// report.
+func (p Provenance) String() string {
+	rval := p.Kind.String()
+
+	if p.SigDigits > 0 {
+		rval += fmt.Sprintf(", %d significant digits", p.SigDigits)
+	}
+
+	if p.Source != "" {
+		rval += " (" + p.Source + ")"
+	}
+
+	return rval
+}
+
+// IsExact returns true if the conversion factor is exact by definition.
+func (p Provenance) IsExact() bool {
+	return p.Kind == ConvExact
+}
+
+// These are commonly used Provenance values
+var (
+	provSI           = Provenance{Kind: ConvExact, Source: SrcSIBrochure}
+	provNISTSP811    = Provenance{Kind: ConvExact, Source: SrcNISTSP811}
+	provNISTHB44     = Provenance{Kind: ConvExact, Source: SrcNISTHB44}
+	provISO80000     = Provenance{Kind: ConvExact, Source: SrcISO80000}
+	provWMA1985      = Provenance{Kind: ConvExact, Source: SrcWMA1985}
+	provIntlYard     = Provenance{Kind: ConvExact, Source: SrcIntlYardPound}
+	provIHC1929      = Provenance{Kind: ConvExact, Source: SrcIHC1929}
+	provFrenchMetric = Provenance{Kind: ConvExact, Source: SrcFrenchMetric}
+	provCommonExact  = Provenance{Kind: ConvExact, Source: SrcCommonUsage}
+	provHistorical   = Provenance{Kind: ConvConventional, Source: SrcHistorical}
+	provColloquial   = Provenance{Kind: ConvConventional, Source: SrcCommonUsage}
+)
+
+// Provenance returns details of where the conversion factor for this Unit
+// comes from. If nothing is recorded for the Unit the Kind will be
+// ConvUnknown.
+func (u Unit) Provenance() Provenance {
+	if u.f == nil {
+		return Provenance{}
+	}
+
+	if u.f.rate != nil {
+		return u.f.rateUnitProvenance(u.id)
+	}
+
+	return u.f.unitProvenance[u.id]
+}
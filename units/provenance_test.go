@@ -0,0 +1,78 @@
+package units
+
+import (
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestProvenance(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		u          Unit
+		expKind    ConvKind
+		expString  string
+		expIsExact bool
+	}{
+		{
+			ID:         testhelper.MkID("SI unit"),
+			u:          GetOrPanic(Distance, "km"),
+			expKind:    ConvExact,
+			expString:  "exact (" + SrcSIBrochure + ")",
+			expIsExact: true,
+		},
+		{
+			ID:         testhelper.MkID("via alias"),
+			u:          GetOrPanic(Distance, "meter"),
+			expKind:    ConvExact,
+			expString:  "exact (" + SrcSIBrochure + ")",
+			expIsExact: true,
+		},
+		{
+			ID:      testhelper.MkID("measured"),
+			u:       GetOrPanic(Mass, "earth-mass"),
+			expKind: ConvMeasured,
+			expString: "measured, 5 significant digits" +
+				" (" + SrcIAU2015 + ")",
+		},
+		{
+			ID:      testhelper.MkID("conventional"),
+			u:       GetOrPanic(Energy, "BTU"),
+			expKind: ConvConventional,
+			expString: "conventional, 6 significant digits" +
+				" (" + SrcISO31 + ")",
+		},
+		{
+			ID:        testhelper.MkID("not recorded"),
+			u:         Unit{},
+			expKind:   ConvUnknown,
+			expString: "unknown",
+		},
+	}
+
+	for _, tc := range testCases {
+		p := tc.u.Provenance()
+		testhelper.DiffString(t, tc.IDStr(), "Kind",
+			p.Kind.String(), tc.expKind.String())
+		testhelper.DiffString(t, tc.IDStr(), "String",
+			p.String(), tc.expString)
+		testhelper.DiffBool(t, tc.IDStr(), "IsExact",
+			p.IsExact(), tc.expIsExact)
+	}
+}
+
+func TestConvKindString(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		ck     ConvKind
+		expStr string
+	}{
+		{ID: testhelper.MkID("exact"), ck: ConvExact, expStr: "exact"},
+		{ID: testhelper.MkID("derived"), ck: ConvDerived, expStr: "derived"},
+		{ID: testhelper.MkID("bad"), ck: ConvKind(99), expStr: "ConvKind(99)"},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "", tc.ck.String(), tc.expStr)
+	}
+}
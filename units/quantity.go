@@ -0,0 +1,226 @@
+package units
+
+import "fmt"
+
+// FamilyMarker is the type constraint for the family parameter of a
+// Quantity. Each built-in Family has a marker type (DistanceF, MassF and so
+// on) whose Family method returns that Family.
+type FamilyMarker interface {
+	Family() *Family
+}
+
+// These are the marker types for the built-in Families. They are used as
+// the type parameter of a Quantity, for instance Quantity[DistanceF].
+type (
+	DimensionlessF struct{}
+	TimeF          struct{}
+	DataF          struct{}
+	DistanceF      struct{}
+	AreaF          struct{}
+	VolumeF        struct{}
+	VelocityF      struct{}
+	MassF          struct{}
+	PressureF      struct{}
+	TemperatureF   struct{}
+	AngleF         struct{}
+	EnergyF        struct{}
+)
+
+// Family returns the dimensionless Family
+func (DimensionlessF) Family() *Family { return numericFamily }
+
+// Family returns the time Family
+func (TimeF) Family() *Family { return timeFamily }
+
+// Family returns the data Family
+func (DataF) Family() *Family { return dataFamily }
+
+// Family returns the distance Family
+func (DistanceF) Family() *Family { return distanceFamily }
+
+// Family returns the area Family
+func (AreaF) Family() *Family { return areaFamily }
+
+// Family returns the volume Family
+func (VolumeF) Family() *Family { return volumeFamily }
+
+// Family returns the velocity Family
+func (VelocityF) Family() *Family { return velocityFamily }
+
+// Family returns the mass Family
+func (MassF) Family() *Family { return massFamily }
+
+// Family returns the pressure Family
+func (PressureF) Family() *Family { return pressureFamily }
+
+// Family returns the temperature Family
+func (TemperatureF) Family() *Family { return temperatureFamily }
+
+// Family returns the angle Family
+func (AngleF) Family() *Family { return angleFamily }
+
+// Family returns the energy Family
+func (EnergyF) Family() *Family { return energyFamily }
+
+// Quantity is a value in one of the units of the Family given by the type
+// parameter, held in the base units of that Family. Since the Family is
+// part of the type, a Quantity[DistanceF] cannot be added to a
+// Quantity[MassF]; the mistake is caught at compile time.
+//
+// The zero value is a zero quantity in the base units.
+type Quantity[F FamilyMarker] struct {
+	base float64
+}
+
+// quantityFamily returns the Family of the Quantity type
+func quantityFamily[F FamilyMarker]() *Family {
+	var marker F
+	return marker.Family()
+}
+
+// NewQuantity returns the Quantity having the value in the named unit. The
+// unit is found as for the Family.GetUnit method. A non-nil error is
+// returned if the unit is not found.
+func NewQuantity[F FamilyMarker](v float64, uName string) (Quantity[F], error) {
+	u, err := quantityFamily[F]().GetUnit(uName)
+	if err != nil {
+		return Quantity[F]{}, err
+	}
+
+	return QuantityOf[F](v, u)
+}
+
+// NewQuantityOrPanic calls NewQuantity and panics if the error is non-nil,
+// otherwise it returns the Quantity.
+func NewQuantityOrPanic[F FamilyMarker](v float64, uName string) Quantity[F] {
+	q, err := NewQuantity[F](v, uName)
+	if err != nil {
+		panic(err)
+	}
+
+	return q
+}
+
+// QuantityOf returns the Quantity having the value in the given unit. A
+// non-nil error is returned if the unit is not in the Family of the
+// Quantity or is invalid.
+func QuantityOf[F FamilyMarker](v float64, u Unit) (Quantity[F], error) {
+	f := quantityFamily[F]()
+	if u.f != f {
+		uFamily := "an unknown family"
+		if u.f != nil {
+			uFamily = u.f.name
+		}
+
+		return Quantity[F]{},
+			fmt.Errorf(
+				"mismatched unit families. Cannot make a Quantity of %s from %s",
+				f.name, uFamily)
+	}
+
+	base, err := convertToBaseUnits(v, u)
+	if err != nil {
+		return Quantity[F]{}, err
+	}
+
+	return Quantity[F]{base: base}, nil
+}
+
+// From returns the Quantity having the value of the ValUnit. A non-nil
+// error is returned if the units of the ValUnit are not in the Family of
+// the Quantity.
+func From[F FamilyMarker](vu ValUnit) (Quantity[F], error) {
+	return QuantityOf[F](vu.V, vu.U)
+}
+
+// FromOrPanic calls From and panics if the error is non-nil, otherwise it
+// returns the Quantity.
+func FromOrPanic[F FamilyMarker](vu ValUnit) Quantity[F] {
+	q, err := From[F](vu)
+	if err != nil {
+		panic(err)
+	}
+
+	return q
+}
+
+// Family returns the Family of the Quantity
+func (q Quantity[F]) Family() *Family {
+	return quantityFamily[F]()
+}
+
+// BaseValue returns the value of the Quantity in the base units of its
+// Family
+func (q Quantity[F]) BaseValue() float64 {
+	return q.base
+}
+
+// ValUnit returns the Quantity as a ValUnit in the base units of its Family
+func (q Quantity[F]) ValUnit() ValUnit {
+	f := q.Family()
+
+	u := f.altUnits[f.baseUnitName]
+	u.id = f.baseUnitName
+
+	return ValUnit{V: q.base, U: u}
+}
+
+// To returns the Quantity as a ValUnit in the given unit. A non-nil error
+// is returned if the unit is not in the Family of the Quantity or is
+// invalid.
+func (q Quantity[F]) To(u Unit) (ValUnit, error) {
+	return q.ValUnit().Convert(u)
+}
+
+// ToOrPanic calls To and panics if the error is non-nil, otherwise it
+// returns the ValUnit.
+func (q Quantity[F]) ToOrPanic(u Unit) ValUnit {
+	vu, err := q.To(u)
+	if err != nil {
+		panic(err)
+	}
+
+	return vu
+}
+
+// In returns the value of the Quantity in the named unit. The unit is found
+// as for the Family.GetUnit method. A non-nil error is returned if the unit
+// is not found.
+func (q Quantity[F]) In(uName string) (float64, error) {
+	u, err := q.Family().GetUnit(uName)
+	if err != nil {
+		return 0, err
+	}
+
+	vu, err := q.To(u)
+
+	return vu.V, err
+}
+
+// Add returns the sum of the two Quantities. Note that the values are
+// added in base units; for a Family whose units have offsets, such as
+// temperature, this may not be what is wanted.
+func (q Quantity[F]) Add(other Quantity[F]) Quantity[F] {
+	return Quantity[F]{base: q.base + other.base}
+}
+
+// Sub returns the difference of the two Quantities
+func (q Quantity[F]) Sub(other Quantity[F]) Quantity[F] {
+	return Quantity[F]{base: q.base - other.base}
+}
+
+// Scale returns the Quantity multiplied by the factor
+func (q Quantity[F]) Scale(factor float64) Quantity[F] {
+	return Quantity[F]{base: q.base * factor}
+}
+
+// Ratio returns the ratio of the two Quantities
+func (q Quantity[F]) Ratio(other Quantity[F]) float64 {
+	return q.base / other.base
+}
+
+// String returns the Quantity formatted as a ValUnit in the base units of
+// its Family
+func (q Quantity[F]) String() string {
+	return q.ValUnit().String()
+}
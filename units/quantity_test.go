@@ -0,0 +1,143 @@
+package units
+
+import (
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestQuantity(t *testing.T) {
+	const epsilon = 1e-9
+
+	foot := GetOrPanic(Distance, "foot")
+	kg := GetOrPanic(Mass, "kg")
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		mk    func() (Quantity[DistanceF], error)
+		expM  float64
+		expFt float64
+	}{
+		{
+			ID: testhelper.MkID("by name"),
+			mk: func() (Quantity[DistanceF], error) {
+				return NewQuantity[DistanceF](3, "feet")
+			},
+			expM:  0.9144,
+			expFt: 3,
+		},
+		{
+			ID: testhelper.MkID("by unit"),
+			mk: func() (Quantity[DistanceF], error) {
+				return QuantityOf[DistanceF](2, foot)
+			},
+			expM:  0.6096,
+			expFt: 2,
+		},
+		{
+			ID: testhelper.MkID("from ValUnit"),
+			mk: func() (Quantity[DistanceF], error) {
+				return From[DistanceF](ValUnit{V: 1, U: foot})
+			},
+			expM:  0.3048,
+			expFt: 1,
+		},
+		{
+			ID: testhelper.MkID("bad unit name"),
+			ExpErr: testhelper.MkExpErr(
+				`there is no unit of distance called "nonesuch"`),
+			mk: func() (Quantity[DistanceF], error) {
+				return NewQuantity[DistanceF](1, "nonesuch")
+			},
+		},
+		{
+			ID: testhelper.MkID("wrong family"),
+			ExpErr: testhelper.MkExpErr("mismatched unit families." +
+				" Cannot make a Quantity of distance from mass"),
+			mk: func() (Quantity[DistanceF], error) {
+				return From[DistanceF](ValUnit{V: 1, U: kg})
+			},
+		},
+		{
+			ID: testhelper.MkID("no family"),
+			ExpErr: testhelper.MkExpErr("mismatched unit families." +
+				" Cannot make a Quantity of distance from an unknown family"),
+			mk: func() (Quantity[DistanceF], error) {
+				return QuantityOf[DistanceF](1, Unit{})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		q, err := tc.mk()
+		if !testhelper.CheckExpErr(t, err, tc) || err != nil {
+			continue
+		}
+
+		testhelper.DiffFloat(t, tc.IDStr(), "metres",
+			q.BaseValue(), tc.expM, epsilon)
+
+		ft, err := q.In("foot")
+		if err != nil {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: unexpected error: %s", err)
+
+			continue
+		}
+
+		testhelper.DiffFloat(t, tc.IDStr(), "feet", ft, tc.expFt, epsilon)
+	}
+}
+
+func TestQuantityArithmetic(t *testing.T) {
+	const epsilon = 1e-9
+
+	a := NewQuantityOrPanic[DistanceF](1, "km")
+	b := NewQuantityOrPanic[DistanceF](500, "metre")
+
+	testhelper.DiffFloat(t, "Add", "metres", a.Add(b).BaseValue(), 1500, epsilon)
+	testhelper.DiffFloat(t, "Sub", "metres", a.Sub(b).BaseValue(), 500, epsilon)
+	testhelper.DiffFloat(t, "Scale", "metres", a.Scale(3).BaseValue(), 3000,
+		epsilon)
+	testhelper.DiffFloat(t, "Ratio", "ratio", a.Ratio(b), 2, epsilon)
+	testhelper.DiffString(t, "String", "string", a.String(), "1000 metres")
+
+	vu := a.ToOrPanic(GetOrPanic(Distance, "mile"))
+	testhelper.DiffFloat(t, "To", "miles", vu.V, 0.621371192237334, epsilon)
+
+	tmp := NewQuantityOrPanic[TemperatureF](212, "Fahrenheit")
+	testhelper.DiffString(t, "temperature", "family",
+		tmp.Family().Name(), Temperature)
+	testhelper.DiffFloat(t, "temperature", "Celsius",
+		tmp.BaseValue(), 100, epsilon)
+}
+
+func TestQuantityMarkers(t *testing.T) {
+	markers := map[string]FamilyMarker{
+		Dimensionless: DimensionlessF{},
+		Time:          TimeF{},
+		Data:          DataF{},
+		Distance:      DistanceF{},
+		Area:          AreaF{},
+		Volume:        VolumeF{},
+		Velocity:      VelocityF{},
+		Mass:          MassF{},
+		Pressure:      PressureF{},
+		Temperature:   TemperatureF{},
+		Angle:         AngleF{},
+		Energy:        EnergyF{},
+	}
+
+	for _, fName := range GetFamilyNames() {
+		m, ok := markers[fName]
+		if !ok {
+			t.Errorf("there is no marker type for the %s Family", fName)
+			continue
+		}
+
+		if m.Family() != GetFamilyOrPanic(fName) {
+			t.Errorf("the marker type for %s has the wrong Family", fName)
+		}
+	}
+}
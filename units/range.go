@@ -0,0 +1,258 @@
+package units
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Range is an interval between two values of the same Family, for instance
+// a specified operating temperature of -20°C to 60°C. The bounds are
+// inclusive and may be in different units. Lo is never greater than Hi.
+type Range struct {
+	Lo, Hi ValUnit
+}
+
+// NewRange returns the Range between the two ValUnits, which may be given in
+// either order. A non-nil error is returned if the units are in different
+// Families or are invalid.
+func NewRange(a, b ValUnit) (Range, error) {
+	c, err := Compare(a, b)
+	if err != nil {
+		return Range{}, err
+	}
+
+	if c > 0 {
+		a, b = b, a
+	}
+
+	return Range{Lo: a, Hi: b}, nil
+}
+
+// NewRangeOrPanic calls NewRange and panics if the error is non-nil,
+// otherwise it returns the Range.
+func NewRangeOrPanic(a, b ValUnit) Range {
+	r, err := NewRange(a, b)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// rangeSeps are the separators between the bounds of a Range which are
+// recognised when parsing. The hyphen is tried last since it may also be
+// the sign of a number.
+var rangeSeps = []string{"..", " to ", "–", "—", "-"}
+
+// parseRangeBound parses one bound of a range, which may have no unit
+func (f *Family) parseRangeBound(s string) (ValUnit, bool, error) {
+	parts := valUnitRE.FindStringSubmatch(s)
+	if parts == nil {
+		return ValUnit{}, false,
+			fmt.Errorf("%q does not start with a number", s)
+	}
+
+	if parts[2] == "" {
+		v, err := strconv.ParseFloat(parts[1], 64)
+		return ValUnit{V: v}, false, err
+	}
+
+	pvu, err := f.parseValUnit(s)
+
+	return pvu.ValUnit, true, err
+}
+
+// splitRange tries to split the string into the two bounds of a range at
+// the index, which is the start of a separator of the given length. If
+// only one bound has a unit the other is taken to be in the same unit.
+func (f *Family) splitRange(s string, i, sepLen int) (Range, bool) {
+	lo, loHasUnit, err := f.parseRangeBound(s[:i])
+	if err != nil {
+		return Range{}, false
+	}
+
+	hi, hiHasUnit, err := f.parseRangeBound(s[i+sepLen:])
+	if err != nil {
+		return Range{}, false
+	}
+
+	switch {
+	case !loHasUnit && !hiHasUnit:
+		return Range{}, false
+	case !loHasUnit:
+		lo.U = hi.U
+	case !hiHasUnit:
+		hi.U = lo.U
+	}
+
+	r, err := NewRange(lo, hi)
+
+	return r, err == nil
+}
+
+// ParseRange parses the string as a Range of values in the Family. The
+// bounds are separated by "..", "to", an en dash or a hyphen, for instance
+// "10-12 ft", "10–12 ft" or "5 km .. 3 mi". A bound without a unit is taken
+// to be in the same unit as the other bound. A non-nil error is returned if
+// the string cannot be parsed.
+func (f *Family) ParseRange(s string) (Range, error) {
+	for _, sep := range rangeSeps {
+		for i := range len(s) {
+			if !strings.HasPrefix(s[i:], sep) {
+				continue
+			}
+
+			if r, ok := f.splitRange(s, i, len(sep)); ok {
+				return r, nil
+			}
+		}
+	}
+
+	return Range{}, fmt.Errorf("%q is not a range of %s values", s, f.name)
+}
+
+// ParseRange parses the string as a Range of values in the named Family.
+// See the Family.ParseRange method for details.
+func ParseRange(fName, s string) (Range, error) {
+	f, err := GetFamily(fName)
+	if err != nil {
+		return Range{}, err
+	}
+
+	return f.ParseRange(s)
+}
+
+// Family returns the Family of the Range
+func (r Range) Family() *Family {
+	return r.Lo.U.f
+}
+
+// Contains returns true if the ValUnit is within the Range, including its
+// bounds. A non-nil error is returned if the ValUnit is not in the Family
+// of the Range.
+func (r Range) Contains(v ValUnit) (bool, error) {
+	cLo, err := Compare(v, r.Lo)
+	if err != nil {
+		return false, err
+	}
+
+	cHi, err := Compare(v, r.Hi)
+	if err != nil {
+		return false, err
+	}
+
+	return cLo >= 0 && cHi <= 0, nil
+}
+
+// Clamp returns the ValUnit if it is within the Range, otherwise it returns
+// the nearest bound of the Range converted to the units of the ValUnit. A
+// non-nil error is returned if the ValUnit is not in the Family of the
+// Range.
+func (r Range) Clamp(v ValUnit) (ValUnit, error) {
+	c, err := Compare(v, r.Lo)
+	if err != nil {
+		return v, err
+	}
+
+	if c < 0 {
+		return r.Lo.Convert(v.U)
+	}
+
+	if CompareOrPanic(v, r.Hi) > 0 {
+		return r.Hi.Convert(v.U)
+	}
+
+	return v, nil
+}
+
+// Intersect returns the Range of values in both Ranges and true. If the
+// Ranges do not overlap it returns false. A non-nil error is returned if
+// the Ranges are in different Families.
+func (r Range) Intersect(other Range) (Range, bool, error) {
+	c, err := Compare(r.Lo, other.Lo)
+	if err != nil {
+		return Range{}, false, err
+	}
+
+	rval := r
+	if c < 0 {
+		rval.Lo = other.Lo
+	}
+
+	if CompareOrPanic(r.Hi, other.Hi) > 0 {
+		rval.Hi = other.Hi
+	}
+
+	if CompareOrPanic(rval.Lo, rval.Hi) > 0 {
+		return Range{}, false, nil
+	}
+
+	return rval, true, nil
+}
+
+// Union returns the smallest Range holding all the values in both Ranges.
+// A non-nil error is returned if the Ranges are in different Families or
+// do not overlap, since the union is then not a single Range.
+func (r Range) Union(other Range) (Range, error) {
+	_, overlap, err := r.Intersect(other)
+	if err != nil {
+		return Range{}, err
+	}
+
+	if !overlap {
+		return Range{}, fmt.Errorf("the ranges %s and %s do not overlap",
+			r, other)
+	}
+
+	rval := r
+	if CompareOrPanic(r.Lo, other.Lo) > 0 {
+		rval.Lo = other.Lo
+	}
+
+	if CompareOrPanic(r.Hi, other.Hi) < 0 {
+		rval.Hi = other.Hi
+	}
+
+	return rval, nil
+}
+
+// Convert returns the Range with both bounds converted to the Unit. A
+// non-nil error is returned if the Unit is not in the Family of the Range
+// or is invalid.
+func (r Range) Convert(u Unit) (Range, error) {
+	lo, err := r.Lo.Convert(u)
+	if err != nil {
+		return Range{}, err
+	}
+
+	hi, err := r.Hi.Convert(u)
+	if err != nil {
+		return Range{}, err
+	}
+
+	return Range{Lo: lo, Hi: hi}, nil
+}
+
+// ConvertOrPanic calls Convert and panics if the error is non-nil,
+// otherwise it returns the Range.
+func (r Range) ConvertOrPanic(u Unit) Range {
+	cr, err := r.Convert(u)
+	if err != nil {
+		panic(err)
+	}
+
+	return cr
+}
+
+// String returns the Range as a string. If both bounds are in the same unit
+// the unit is given once, for instance "10–12 ft", otherwise each bound has
+// its own unit, for instance "-20 °C – 60 °F".
+func (r Range) String() string {
+	if r.Lo.U.f == r.Hi.U.f && r.Lo.U.id == r.Hi.U.id {
+		return fmt.Sprintf("%.5g–%.5g %s", r.Lo.V, r.Hi.V, r.Lo.U.Abbrev())
+	}
+
+	return fmt.Sprintf("%.5g %s – %.5g %s",
+		r.Lo.V, r.Lo.U.Abbrev(), r.Hi.V, r.Hi.U.Abbrev())
+}
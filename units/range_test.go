@@ -0,0 +1,145 @@
+package units
+
+import (
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestParseRange(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		fName  string
+		s      string
+		expStr string
+	}{
+		{
+			ID:     testhelper.MkID("hyphen, shared unit"),
+			fName:  "distance",
+			s:      "10-12 ft",
+			expStr: "10–12 ft",
+		},
+		{
+			ID:     testhelper.MkID("en dash, unit on both"),
+			fName:  "distance",
+			s:      "10 ft–12 ft",
+			expStr: "10–12 ft",
+		},
+		{
+			ID:     testhelper.MkID("dots, bounds reversed"),
+			fName:  "distance",
+			s:      "5 km .. 3 mi",
+			expStr: "3 mi – 5 km",
+		},
+		{
+			ID:     testhelper.MkID("to, negative numbers"),
+			fName:  "temperature",
+			s:      "-20 °C to 60 °F",
+			expStr: "-20 °C – 60 °F",
+		},
+		{
+			ID:     testhelper.MkID("hyphen, negative numbers"),
+			fName:  "temperature",
+			s:      "-20--10 C",
+			expStr: "-20–-10 °C",
+		},
+		{
+			ID:     testhelper.MkID("hyphen, exponents"),
+			fName:  "distance",
+			s:      "1e-3-2e-3 m",
+			expStr: "0.001–0.002 m",
+		},
+		{
+			ID:    testhelper.MkID("no unit"),
+			fName: "distance",
+			s:     "10-12",
+			ExpErr: testhelper.MkExpErr(
+				`"10-12" is not a range of distance values`),
+		},
+		{
+			ID:    testhelper.MkID("bad unit"),
+			fName: "distance",
+			s:     "10-12 kg",
+			ExpErr: testhelper.MkExpErr(
+				`"10-12 kg" is not a range of distance values`),
+		},
+		{
+			ID:    testhelper.MkID("bad family"),
+			fName: "nonesuch",
+			s:     "10-12 ft",
+			ExpErr: testhelper.MkExpErr(
+				`there is no unit family called "nonesuch"`),
+		},
+	}
+
+	for _, tc := range testCases {
+		r, err := ParseRange(tc.fName, tc.s)
+		if testhelper.CheckExpErr(t, err, tc) && err == nil {
+			testhelper.DiffString(t, tc.IDStr(), "range", r.String(), tc.expStr)
+		}
+	}
+}
+
+func TestRangeOperations(t *testing.T) {
+	feet := distanceFamily.GetUnitOrPanic("foot")
+	r := NewRangeOrPanic(mkVU(distanceFamily, 12, "foot"),
+		mkVU(distanceFamily, 10, "foot"))
+	testhelper.DiffString(t, "NewRange", "range", r.String(), "10–12 ft")
+
+	in, err := r.Contains(mkVU(distanceFamily, 3.5, "metre"))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	testhelper.DiffBool(t, "Contains", "3.5 m", in, true)
+
+	in, _ = r.Contains(mkVU(distanceFamily, 3.7, "metre"))
+	testhelper.DiffBool(t, "Contains", "3.7 m", in, false)
+
+	_, err = r.Contains(mkVU(massFamily, 1, "kg"))
+	testhelper.CheckExpErr(t, err, struct {
+		testhelper.ID
+		testhelper.ExpErr
+	}{
+		ID: testhelper.MkID("Contains, wrong family"),
+		ExpErr: testhelper.MkExpErr(
+			"mismatched unit families. Cannot compare mass with distance"),
+	})
+
+	clamped, _ := r.Clamp(mkVU(distanceFamily, 5, "metre"))
+	testhelper.DiffString(t, "Clamp", "above", clamped.String(), "3.6576 metres")
+
+	clamped, _ = r.Clamp(mkVU(distanceFamily, 11, "foot"))
+	testhelper.DiffString(t, "Clamp", "within", clamped.String(), "11 feet")
+
+	other := NewRangeOrPanic(mkVU(distanceFamily, 11, "foot"),
+		mkVU(distanceFamily, 5, "yard"))
+
+	isect, ok, _ := r.Intersect(other)
+	testhelper.DiffBool(t, "Intersect", "overlaps", ok, true)
+	testhelper.DiffString(t, "Intersect", "range", isect.String(), "11–12 ft")
+
+	union, _ := r.Union(other)
+	testhelper.DiffString(t, "Union", "range", union.String(), "10 ft – 5 yd")
+
+	converted := union.ConvertOrPanic(feet)
+	testhelper.DiffString(t, "Convert", "range",
+		converted.String(), "10–15 ft")
+
+	apart := NewRangeOrPanic(mkVU(distanceFamily, 20, "foot"),
+		mkVU(distanceFamily, 30, "foot"))
+
+	_, ok, _ = r.Intersect(apart)
+	testhelper.DiffBool(t, "Intersect", "apart", ok, false)
+
+	_, err = r.Union(apart)
+	testhelper.CheckExpErr(t, err, struct {
+		testhelper.ID
+		testhelper.ExpErr
+	}{
+		ID: testhelper.MkID("Union, apart"),
+		ExpErr: testhelper.MkExpErr(
+			"the ranges 10–12 ft and 20–30 ft do not overlap"),
+	})
+}
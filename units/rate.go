@@ -0,0 +1,256 @@
+package units
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// rateSep separates the numerator and denominator in the names of rate
+// Families and their units
+const rateSep = "/"
+
+// rateFamily records the component Families of a rate Family
+type rateFamily struct {
+	num, den *Family
+}
+
+// rateFamilies caches the rate Families which have been synthesised, keyed
+// by their canonical names
+var (
+	rateFamiliesMtx sync.Mutex
+	rateFamilies    = map[string]*Family{}
+)
+
+// IsRate returns true if the Family is a rate Family synthesised from two
+// other Families (see GetFamily)
+func (f *Family) IsRate() bool {
+	return f.rate != nil
+}
+
+// RateFamilies returns the numerator and denominator Families of a rate
+// Family. If the Family is not a rate Family both values are nil.
+func (f *Family) RateFamilies() (num, den *Family) {
+	if f.rate == nil {
+		return nil, nil
+	}
+
+	return f.rate.num, f.rate.den
+}
+
+// getRateFamily returns the rate Family with the given name, which should
+// be the names (or aliases) of two Families separated by a slash, for
+// instance "volume/time". The rate Family is created when it is first
+// asked for and then cached. Neither of the two Families may itself be a
+// rate Family so a name such as "distance/time/time" is rejected; this
+// keeps the number of rate Families, and so the size of the cache,
+// bounded.
+func getRateFamily(fName string) (*Family, error) {
+	i := strings.Index(fName, rateSep)
+	if i < 0 || strings.Contains(fName[i+1:], rateSep) {
+		return nil,
+			fmt.Errorf("there is no unit family called %q"+
+				" (a rate family cannot be made from another rate family)",
+				fName)
+	}
+
+	num, err := GetFamily(fName[:i])
+	if err != nil {
+		return nil, err
+	}
+
+	den, err := GetFamily(fName[i+1:])
+	if err != nil {
+		return nil, err
+	}
+
+	name := num.name + rateSep + den.name
+
+	rateFamiliesMtx.Lock()
+	defer rateFamiliesMtx.Unlock()
+
+	if f, ok := rateFamilies[name]; ok {
+		return f, nil
+	}
+
+	f := newRateFamily(num, den)
+	rateFamilies[name] = f
+
+	return f, nil
+}
+
+// newRateFamily creates the rate Family made from the numerator and
+// denominator Families. There is a unit of the rate Family for every pair
+// of numerator and denominator units and so there can be very many of
+// them; they are not created here but only when they are asked for (see
+// getRateUnit and rateUnits).
+func newRateFamily(num, den *Family) *Family {
+	return &Family{
+		baseUnitName: num.baseUnitName + rateSep + den.baseUnitName,
+		description:  num.description + " per " + den.name,
+		name:         num.name + rateSep + den.name,
+		altUnits:     map[string]Unit{},
+		unitAliases:  map[string]string{},
+		rate:         &rateFamily{num: num, den: den},
+	}
+}
+
+// rateUnit returns the unit of the rate Family made from the numerator and
+// denominator units. Any offsets in the component units are ignored; a
+// rate is a ratio of differences and so only the conversion factors
+// matter.
+func (f *Family) rateUnit(nu, du Unit) Unit {
+	return Unit{
+		id:         nu.id + rateSep + du.id,
+		convFactor: nu.convFactor / du.convFactor,
+		f:          f,
+		abbrev:     nu.abbrev + rateSep + du.abbrev,
+		name:       nu.name + rateSep + du.name,
+		namePlural: nu.namePlural + rateSep + du.name,
+		notes: fmt.Sprintf("%s per %s, derived from the %s and %s units",
+			nu.namePlural, du.name, nu.f.name, du.f.name),
+	}
+}
+
+// rateProvenance returns the Provenance of a rate unit derived from the
+// Provenance of its component units
+func rateProvenance(np, dp Provenance) Provenance {
+	switch {
+	case np.Kind == ConvUnknown || dp.Kind == ConvUnknown:
+		return Provenance{}
+	case np.IsExact() && dp.IsExact():
+		return Provenance{Kind: ConvExact}
+	}
+
+	sigDigits := np.SigDigits
+	if sigDigits == 0 || (dp.SigDigits > 0 && dp.SigDigits < sigDigits) {
+		sigDigits = dp.SigDigits
+	}
+
+	return Provenance{Kind: ConvDerived, SigDigits: sigDigits}
+}
+
+// unitCount returns the number of units in the Family. The units of a rate
+// Family are not held in the Family so they are counted from its component
+// Families.
+func (f *Family) unitCount() int {
+	if f.rate != nil {
+		return len(f.rate.num.altUnits) * len(f.rate.den.altUnits)
+	}
+
+	return len(f.altUnits)
+}
+
+// rateUnits returns all the units of the rate Family. Note that these are
+// made afresh on each call and there may be very many of them.
+func (f *Family) rateUnits() []Unit {
+	units := make([]Unit, 0, f.unitCount())
+
+	for nID, nu := range f.rate.num.altUnits {
+		nu.id = nID
+
+		for dID, du := range f.rate.den.altUnits {
+			du.id = dID
+			units = append(units, f.rateUnit(nu, du))
+		}
+	}
+
+	return units
+}
+
+// rateComponents returns the numerator and denominator units of the rate
+// unit with the given ID. Each slash in the ID is tried in turn since the
+// unit IDs may themselves contain slashes. It returns false if the ID is
+// not that of a unit of the rate Family.
+func (f *Family) rateComponents(id string) (nu, du Unit, ok bool) {
+	for i := range len(id) {
+		if !strings.HasPrefix(id[i:], rateSep) {
+			continue
+		}
+
+		nu, nOK := f.rate.num.altUnits[id[:i]]
+		du, dOK := f.rate.den.altUnits[id[i+1:]]
+
+		if nOK && dOK {
+			nu.id = id[:i]
+			du.id = id[i+1:]
+
+			return nu, du, true
+		}
+	}
+
+	return Unit{}, Unit{}, false
+}
+
+// rateUnitProvenance returns the Provenance of the rate unit with the given
+// ID, derived from that of its component units
+func (f *Family) rateUnitProvenance(id string) Provenance {
+	nu, du, ok := f.rateComponents(id)
+	if !ok {
+		return Provenance{}
+	}
+
+	return rateProvenance(nu.Provenance(), du.Provenance())
+}
+
+// rateUnitUCUM returns the UCUM code of the rate unit with the given ID,
+// made from the codes of its component units. It returns false if either
+// component unit has no UCUM code.
+func (f *Family) rateUnitUCUM(id string) (string, bool) {
+	nu, du, ok := f.rateComponents(id)
+	if !ok {
+		return "", false
+	}
+
+	nCode, nOK := nu.UCUM()
+	dCode, dOK := du.UCUM()
+
+	if !nOK || !dOK {
+		return "", false
+	}
+
+	return ucumRateCode(nCode, dCode), true
+}
+
+// getRateUnit finds the unit in the rate Family by splitting the name into
+// the names of a numerator and a denominator unit, for instance "kg/m²",
+// "litres/hour" or, unless strict is true, "litres per hour". Each slash
+// in the name is tried in turn since the unit names may themselves contain
+// slashes. If strict is true the unit names must exactly match a unit name
+// or alias (see Family.GetUnitStrict). It returns false if the unit is not
+// found.
+func (f *Family) getRateUnit(uName string, strict bool) (Unit, bool) {
+	get := (*Family).getUnit
+	name := uName
+
+	if strict {
+		get = (*Family).getUnitStrict
+	} else {
+		name = perRE.ReplaceAllString(strings.TrimSpace(uName), rateSep)
+	}
+
+	for i := range len(name) {
+		if !strings.HasPrefix(name[i:], rateSep) {
+			continue
+		}
+
+		nu, err := get(f.rate.num, name[:i])
+		if err != nil {
+			continue
+		}
+
+		du, err := get(f.rate.den, name[i+1:])
+		if err != nil {
+			continue
+		}
+
+		u := f.rateUnit(nu, du)
+		if uName != u.id {
+			u.alias = uName
+		}
+
+		return u, true
+	}
+
+	return Unit{}, false
+}
@@ -0,0 +1,181 @@
+package units
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestGetRateUnit(t *testing.T) {
+	const epsilon = 1e-12
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		fName     string
+		uName     string
+		expID     string
+		expAbbrev string
+		expFactor float64
+	}{
+		{
+			ID:        testhelper.MkID("volume per time"),
+			fName:     "volume/time",
+			uName:     "litre/hour",
+			expID:     "litre/hour",
+			expAbbrev: "l/hr",
+			expFactor: 0.001 / 3600,
+		},
+		{
+			ID:        testhelper.MkID("by alias"),
+			fName:     "mass/area",
+			uName:     "kg/m²",
+			expID:     "kg/square metre",
+			expAbbrev: "kg/m²",
+			expFactor: 1000,
+		},
+		{
+			ID:        testhelper.MkID("data per time"),
+			fName:     "data/time",
+			uName:     "GiB/day",
+			expID:     "GiB/day",
+			expAbbrev: "GiB/day",
+			expFactor: 1 << 30 / 86400.0,
+		},
+		{
+			ID:        testhelper.MkID("normalised names"),
+			fName:     "volume/time",
+			uName:     "litres/hours",
+			expID:     "litre/hour",
+			expAbbrev: "l/hr",
+			expFactor: 0.001 / 3600,
+		},
+		{
+			ID:        testhelper.MkID("unit name with a slash"),
+			fName:     "velocity/time",
+			uName:     "metre/second/second",
+			expID:     "metre/second/second",
+			expAbbrev: "m/s/sec",
+			expFactor: 1,
+		},
+		{
+			ID:        testhelper.MkID("per"),
+			fName:     "volume/time",
+			uName:     "litres per hour",
+			expID:     "litre/hour",
+			expAbbrev: "l/hr",
+			expFactor: 0.001 / 3600,
+		},
+		{
+			ID:    testhelper.MkID("rate of a rate"),
+			fName: "distance/time/time",
+			uName: "metre/second/second",
+			ExpErr: testhelper.MkExpErr(
+				`there is no unit family called "distance/time/time"` +
+					` (a rate family cannot be made from another rate family)`),
+		},
+		{
+			ID:        testhelper.MkID("family alias"),
+			fName:     "speed/time",
+			uName:     "metre/second/second",
+			expID:     "metre/second/second",
+			expAbbrev: "m/s/sec",
+			expFactor: 1,
+		},
+		{
+			ID:     testhelper.MkID("bad unit"),
+			ExpErr: testhelper.MkExpErr(`there is no unit of mass per area called "kg/furlong"`),
+			fName:  "mass/area",
+			uName:  "kg/furlong",
+		},
+		{
+			ID:     testhelper.MkID("bad family"),
+			ExpErr: testhelper.MkExpErr(`there is no unit family called "furlong"`),
+			fName:  "distance/furlong",
+			uName:  "metre/furlong",
+		},
+	}
+
+	for _, tc := range testCases {
+		u, err := Get(tc.fName, tc.uName)
+		if !testhelper.CheckExpErr(t, err, tc) || err != nil {
+			continue
+		}
+
+		testhelper.DiffString(t, tc.IDStr(), "ID", u.ID(), tc.expID)
+		testhelper.DiffString(t, tc.IDStr(), "abbreviation",
+			u.Abbrev(), tc.expAbbrev)
+		testhelper.DiffFloat(t, tc.IDStr(), "factor",
+			u.ConvFactor(), tc.expFactor, epsilon)
+	}
+}
+
+func TestRateFamily(t *testing.T) {
+	f := GetFamilyOrPanic("volume/time")
+
+	testhelper.DiffString(t, "volume/time", "name", f.Name(), "volume/time")
+	testhelper.DiffString(t, "volume/time", "description",
+		f.Description(), "unit of volume per time")
+	testhelper.DiffString(t, "volume/time", "base unit",
+		f.BaseUnitName(), "cubic metre/second")
+	testhelper.DiffBool(t, "volume/time", "is rate", f.IsRate(), true)
+	testhelper.DiffBool(t, "volume", "is rate",
+		GetFamilyOrPanic(Volume).IsRate(), false)
+
+	num, den := f.RateFamilies()
+	if num != GetFamilyOrPanic(Volume) || den != GetFamilyOrPanic(Time) {
+		t.Errorf("the rate Families are wrong: %s, %s", num, den)
+	}
+
+	testhelper.DiffInt(t, "volume/time", "number of units",
+		len(f.GetUnits()),
+		len(GetFamilyOrPanic(Volume).GetUnits())*
+			len(GetFamilyOrPanic(Time).GetUnits()))
+
+	testhelper.DiffString(t, "volume/time", "string",
+		f.String(),
+		fmt.Sprintf("volume/time: a unit of volume per time."+
+			" With %d units. Base unit: cubic metre/second.",
+			len(f.GetUnits())))
+
+	if GetFamilyOrPanic("volume/time") != f {
+		t.Errorf("the rate Family is not cached")
+	}
+
+	if len(f.altUnits) != 0 {
+		t.Errorf("the rate Family should not hold any units, it has %d",
+			len(f.altUnits))
+	}
+
+	u := f.GetUnitOrPanic("litre/hour")
+	vu := ValUnit{V: 3600, U: u}.ConvertOrPanic(f.GetUnitOrPanic("ml/sec"))
+	testhelper.DiffFloat(t, "3600 l/hr", "ml/sec", vu.V, 1000, 1e-9)
+	testhelper.DiffString(t, "2 l/hr", "string",
+		ValUnit{V: 2, U: u}.String(), "2 litres/hour")
+	testhelper.DiffString(t, "litre/hour", "provenance",
+		u.Provenance().String(), "exact")
+}
+
+func TestRateFamilyConcurrency(t *testing.T) {
+	const goroutines = 8
+
+	var wg sync.WaitGroup
+
+	families := make([]*Family, goroutines)
+
+	for i := range goroutines {
+		wg.Go(func() {
+			families[i] = GetFamilyOrPanic("energy/mass")
+		})
+	}
+
+	wg.Wait()
+
+	for i, f := range families {
+		if f != families[0] {
+			t.Errorf("goroutine %d got a different Family", i)
+		}
+	}
+}
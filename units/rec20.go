@@ -0,0 +1,85 @@
+package units
+
+import (
+	"fmt"
+	"strings"
+)
+
+// rec20Index maps UN/ECE Recommendation 20 codes to the corresponding
+// Unit. It is populated from the unitRec20 values in the entries in
+// unitFamilies.
+var rec20Index = map[string]Unit{}
+
+// populateRec20Index adds the Recommendation 20 codes of the Family to the
+// index
+func (f *Family) populateRec20Index() {
+	for id, code := range f.unitRec20 {
+		u, ok := f.altUnits[id]
+		if !ok {
+			panic(
+				fmt.Errorf(
+					"the Rec 20 code %q on Family %q is for an unknown Unit: %q",
+					code, f.name, id))
+		}
+
+		if other, ok := rec20Index[code]; ok {
+			panic(
+				fmt.Errorf(
+					"there is a duplicate Rec 20 code:"+
+						" Unit %q (%s) has the code %q and so does Unit %q (%s)",
+					id, f.name, code, other.id, other.f.name))
+		}
+
+		u.id = id
+		rec20Index[code] = u
+	}
+}
+
+// Rec20 returns the UN/ECE Recommendation 20 common code for the Unit, as
+// used in EDI messages and electronic invoices (for instance "KGM" for the
+// kilogram), and true. If the Unit has no such code it returns false.
+func (u Unit) Rec20() (string, bool) {
+	if u.f == nil {
+		return "", false
+	}
+
+	code, ok := u.f.unitRec20[u.id]
+
+	return code, ok
+}
+
+// GetRec20 returns the Unit having the UN/ECE Recommendation 20 common
+// code. Leading and trailing white space is ignored as is the case of the
+// code. A non-nil error is returned if there is no such Unit.
+func GetRec20(code string) (Unit, error) {
+	u, ok := rec20Index[strings.ToUpper(strings.TrimSpace(code))]
+	if !ok {
+		return Unit{},
+			fmt.Errorf("there is no unit with the Rec 20 code %q", code)
+	}
+
+	return u, nil
+}
+
+// GetRec20OrPanic calls GetRec20 and panics if the error is non-nil,
+// otherwise it returns the Unit.
+func GetRec20OrPanic(code string) Unit {
+	u, err := GetRec20(code)
+	if err != nil {
+		panic(err)
+	}
+
+	return u
+}
+
+// ValUnitFromRec20 returns the ValUnit having the value and the unit with
+// the UN/ECE Recommendation 20 common code, as found in an inbound trade
+// document. A non-nil error is returned if there is no such unit.
+func ValUnitFromRec20(v float64, code string) (ValUnit, error) {
+	u, err := GetRec20(code)
+	if err != nil {
+		return ValUnit{}, err
+	}
+
+	return ValUnit{V: v, U: u}, nil
+}
@@ -0,0 +1,98 @@
+package units
+
+import (
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestRec20Codes(t *testing.T) {
+	for _, f := range unitFamilies {
+		for id, code := range f.unitRec20 {
+			u, err := GetRec20(code)
+			if err != nil {
+				t.Errorf("%s: %q: unexpected error: %s", f.name, code, err)
+				continue
+			}
+
+			if u.f != f || u.id != id {
+				t.Errorf("%s: %q: expected unit %q, got %q (%s)",
+					f.name, code, id, u.id, u.f.name)
+			}
+
+			gotCode, ok := u.Rec20()
+			if !ok || gotCode != code {
+				t.Errorf("%s: %q: the unit has the code %q (%t)",
+					f.name, code, gotCode, ok)
+			}
+		}
+	}
+}
+
+func TestGetRec20(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		code   string
+		expFam string
+		expID  string
+	}{
+		{
+			ID:     testhelper.MkID("metre"),
+			code:   "MTR",
+			expFam: "distance",
+			expID:  "metre",
+		},
+		{
+			ID:     testhelper.MkID("kilogram"),
+			code:   "KGM",
+			expFam: "mass",
+			expID:  "kg",
+		},
+		{
+			ID:     testhelper.MkID("US gallon"),
+			code:   "GLL",
+			expFam: "volume",
+			expID:  "US-gallon",
+		},
+		{
+			ID:     testhelper.MkID("lower case, with spaces"),
+			code:   " ltr ",
+			expFam: "volume",
+			expID:  "litre",
+		},
+		{
+			ID:     testhelper.MkID("unknown"),
+			code:   "XYZ",
+			ExpErr: testhelper.MkExpErr(`there is no unit with the Rec 20 code "XYZ"`),
+		},
+	}
+
+	for _, tc := range testCases {
+		u, err := GetRec20(tc.code)
+		if testhelper.CheckExpErr(t, err, tc) && err == nil {
+			testhelper.DiffString(t, tc.IDStr(), "family", u.f.name, tc.expFam)
+			testhelper.DiffString(t, tc.IDStr(), "unit ID", u.id, tc.expID)
+		}
+	}
+}
+
+func TestValUnitFromRec20(t *testing.T) {
+	const epsilon = 1e-9
+
+	vu, err := ValUnitFromRec20(12, "FOT")
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	m, err := vu.Convert(GetRec20OrPanic("MTR"))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	testhelper.DiffFloat(t, "12 FOT", "metres", m.V, 3.6576, epsilon)
+
+	code, ok := m.U.Rec20()
+	testhelper.DiffBool(t, "12 FOT", "has code", ok, true)
+	testhelper.DiffString(t, "12 FOT", "code", code, "MTR")
+}
@@ -112,9 +112,25 @@ var sampleNames = map[string]Unit{
 	},
 }
 
+// sampleProvenance records the source of the conversion factor for each
+// sample unit
+var sampleProvenance = map[string]Provenance{
+	SampleUnitBase:   {Kind: ConvExact, Source: SrcTestData},
+	SampleUnitA:      {Kind: ConvExact, Source: SrcTestData},
+	SampleUnit2T:     {Kind: ConvExact, Source: SrcTestData},
+	SampleUnit001:    {Kind: ConvExact, Source: SrcTestData},
+	SampleUnit123:    {Kind: ConvExact, Source: SrcTestData},
+	SampleUnitNeg123: {Kind: ConvExact, Source: SrcTestData},
+	SampleUnitBad: {
+		Kind: ConvConventional, Source: SrcTestData, SigDigits: 1,
+	},
+}
+
 func init() {
 	SampleFamily.altUnits = sampleNames
+	SampleFamily.unitProvenance = sampleProvenance
 	SampleFamily.populateUnitAliases()
+	SampleFamily.populateNormalisedNames()
 }
 
 // BadSampleFamily is a Family that can be used to test the behaviour of
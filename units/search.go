@@ -0,0 +1,278 @@
+package units
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// MatchField identifies the part of a Unit that matched a search query
+type MatchField int
+
+// These MatchField values identify the part of the Unit that matched. They
+// are given in the order that they are preferred when a query matches more
+// than one part of a Unit.
+const (
+	MatchID MatchField = iota
+	MatchAlias
+	MatchName
+	MatchAbbrev
+	MatchTag
+	MatchNotes
+)
+
+var matchFieldNames = map[MatchField]string{
+	MatchID:     "ID",
+	MatchAlias:  "alias",
+	MatchName:   "name",
+	MatchAbbrev: "abbreviation",
+	MatchTag:    "tag",
+	MatchNotes:  "notes",
+}
+
+// String returns the name of the MatchField
+func (mf MatchField) String() string {
+	if s, ok := matchFieldNames[mf]; ok {
+		return s
+	}
+
+	return "MatchField(" + strconv.Itoa(int(mf)) + ")"
+}
+
+// These weights give the maximum score for a match on each field
+var matchFieldWeight = map[MatchField]float64{
+	MatchID:     1.0,
+	MatchAlias:  0.95,
+	MatchName:   0.9,
+	MatchAbbrev: 0.85,
+	MatchTag:    0.5,
+	MatchNotes:  0.4,
+}
+
+// partialMatchFactor reduces the score of a match where the query is only
+// close to or part of the matched value
+const partialMatchFactor = 0.6
+
+// minFuzzyLen is the minimum length of a query for a close (rather than
+// exact) match to be accepted. Shorter queries match too many names.
+const minFuzzyLen = 4
+
+// charsPerSearchEdit gives the number of characters in the query for each
+// difference allowed in a close match.
+const charsPerSearchEdit = 4
+
+// SearchResult records a Unit which matched a search query. The Field
+// records which part of the Unit matched and the Score gives the relevance
+// of the match, between 0 and 1, with higher values being more relevant.
+type SearchResult struct {
+	Family *Family
+	Unit   Unit
+	Field  MatchField
+	Score  float64
+}
+
+// textScore returns a score, between 0 and 1, for how well the query (which
+// should already have been normalised) matches the candidate text. An exact
+// match of the normalised names scores 1, a close match or a match of part
+// of the text scores less and no match scores 0.
+func textScore(normQuery, candidate string) float64 {
+	normCand := normaliseUnitName(candidate)
+	if normCand == "" {
+		return 0
+	}
+
+	if normCand == normQuery {
+		return 1
+	}
+
+	qLen := len([]rune(normQuery))
+	if qLen < minFuzzyLen {
+		return 0
+	}
+
+	dist := editDistance(normQuery, normCand)
+	if dist <= qLen/charsPerSearchEdit {
+		return (1 - float64(dist)/float64(qLen+1)) * partialMatchFactor
+	}
+
+	if strings.Contains(normCand, normQuery) {
+		cLen := len([]rune(normCand))
+
+		return (float64(qLen) / float64(cLen)) * partialMatchFactor
+	}
+
+	return 0
+}
+
+// textWords splits the text into lower-case words
+func textWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// notesScore returns a score, between 0 and 1, for how well the query
+// words match the notes. It is the proportion of the words in the query
+// that appear as words in the notes.
+func notesScore(words []string, notes string) float64 {
+	if len(words) == 0 {
+		return 0
+	}
+
+	notesWords := textWords(notes)
+	found := 0
+
+	for _, w := range words {
+		if slices.Contains(notesWords, w) {
+			found++
+		}
+	}
+
+	return float64(found) / float64(len(words))
+}
+
+// searchQuery holds the query together with the forms of it used when
+// matching. These are found once for each search rather than for each unit.
+type searchQuery struct {
+	text  string
+	norm  string
+	words []string
+}
+
+// newSearchQuery returns the searchQuery for the query text
+func newSearchQuery(query string) searchQuery {
+	return searchQuery{
+		text:  query,
+		norm:  normaliseUnitName(query),
+		words: textWords(query),
+	}
+}
+
+// searchUnit returns the best match of the query against the given unit. It
+// returns false if the unit doesn't match at all.
+func searchUnit(f *Family, uID string, u Unit, q searchQuery,
+	fields []MatchField,
+) (SearchResult, bool) {
+	best := SearchResult{Family: f}
+
+	consider := func(mf MatchField, score float64) {
+		if !slices.Contains(fields, mf) {
+			return
+		}
+
+		score *= matchFieldWeight[mf]
+		if score > best.Score {
+			best.Field = mf
+			best.Score = score
+		}
+	}
+
+	consider(MatchID, textScore(q.norm, uID))
+
+	for a := range u.aliases {
+		consider(MatchAlias, textScore(q.norm, a))
+	}
+
+	consider(MatchName, max(
+		textScore(q.norm, u.name),
+		textScore(q.norm, u.namePlural)))
+
+	if u.abbrev == q.text {
+		consider(MatchAbbrev, 1)
+	} else if strings.EqualFold(u.abbrev, q.text) {
+		consider(MatchAbbrev, partialMatchFactor)
+	}
+
+	for _, t := range u.tags {
+		if strings.EqualFold(string(t), strings.TrimSpace(q.text)) {
+			consider(MatchTag, 1)
+		}
+	}
+
+	consider(MatchNotes, notesScore(q.words, u.notes))
+
+	if best.Score == 0 {
+		return best, false
+	}
+
+	u.id = uID
+	best.Unit = u
+
+	return best, true
+}
+
+// allMatchFields lists all the MatchField values
+var allMatchFields = []MatchField{
+	MatchID, MatchAlias, MatchName, MatchAbbrev, MatchTag, MatchNotes,
+}
+
+// Search returns every Unit, from any Family, which matches the query. If
+// any fields are given then only those parts of the Unit are searched,
+// otherwise all the fields are searched. Each Unit appears at most once in
+// the results, with the field giving the best match.
+//
+// The results are sorted with the most relevant first; results with the
+// same score are sorted by Family name and then by Unit ID.
+func Search(query string, fields ...MatchField) []SearchResult {
+	if len(fields) == 0 {
+		fields = allMatchFields
+	}
+
+	rval := []SearchResult{}
+	q := newSearchQuery(query)
+
+	for _, f := range unitFamilies {
+		rval = append(rval, f.search(q, fields)...)
+	}
+
+	sortSearchResults(rval)
+
+	return rval
+}
+
+// Search returns every Unit in the Family which matches the query. See the
+// Search func for details.
+func (f *Family) Search(query string, fields ...MatchField) []SearchResult {
+	if len(fields) == 0 {
+		fields = allMatchFields
+	}
+
+	rval := f.search(newSearchQuery(query), fields)
+
+	sortSearchResults(rval)
+
+	return rval
+}
+
+// search returns the unsorted search results for the Family
+func (f *Family) search(q searchQuery, fields []MatchField) []SearchResult {
+	rval := []SearchResult{}
+
+	for uID, u := range f.altUnits {
+		if sr, ok := searchUnit(f, uID, u, q, fields); ok {
+			rval = append(rval, sr)
+		}
+	}
+
+	return rval
+}
+
+// sortSearchResults sorts the search results into order of descending score
+// then by Family name and Unit ID
+func sortSearchResults(results []SearchResult) {
+	slices.SortFunc(results, func(a, b SearchResult) int {
+		switch {
+		case a.Score > b.Score:
+			return -1
+		case a.Score < b.Score:
+			return 1
+		}
+
+		if c := strings.Compare(a.Family.name, b.Family.name); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.Unit.id, b.Unit.id)
+	})
+}
@@ -0,0 +1,107 @@
+package units
+
+import (
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+// searchResultStrings converts the search results into a slice of strings
+// of the form family/unit:field
+func searchResultStrings(results []SearchResult) []string {
+	rval := []string{}
+	for _, sr := range results {
+		rval = append(rval,
+			sr.Family.Name()+"/"+sr.Unit.ID()+":"+sr.Field.String())
+	}
+
+	return rval
+}
+
+func TestSearch(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		query      string
+		fields     []MatchField
+		expResults []string
+	}{
+		{
+			ID:    testhelper.MkID("in more than one family"),
+			query: "electronvolt",
+			expResults: []string{
+				"energy/electronvolt:ID",
+				"mass/electronvolt:ID",
+				"mass/gigaelectronvolt:ID",
+			},
+		},
+		{
+			ID:    testhelper.MkID("normalised name"),
+			query: "20ftshippingcontainer",
+			expResults: []string{
+				"distance/20ft shipping container:ID",
+				"volume/20ft shipping container:ID",
+			},
+		},
+		{
+			ID:    testhelper.MkID("abbreviation"),
+			query: "ft",
+			expResults: []string{
+				"distance/foot:alias",
+				"distance/Indian survey foot:abbreviation",
+				"distance/US survey foot:abbreviation",
+			},
+		},
+		{
+			ID:     testhelper.MkID("alias"),
+			query:  "statute foot",
+			fields: []MatchField{MatchAlias},
+			expResults: []string{
+				"distance/foot:alias",
+			},
+		},
+		{
+			ID:     testhelper.MkID("tag"),
+			query:  "apothecary",
+			fields: []MatchField{MatchTag},
+			expResults: []string{
+				"mass/drachm:tag",
+				"mass/dram:tag",
+				"mass/grain:tag",
+				"mass/scruple:tag",
+				"mass/troy-ounce:tag",
+				"volume/fluid-drachm:tag",
+				"volume/fluid-ounce:tag",
+				"volume/fluid-scruple:tag",
+				"volume/minim:tag",
+			},
+		},
+		{
+			ID:    testhelper.MkID("notes"),
+			query: "Napoleon",
+			expResults: []string{
+				"temperature/Re:notes",
+			},
+		},
+		{
+			ID:         testhelper.MkID("no match"),
+			query:      "nonesuch",
+			expResults: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		results := Search(tc.query, tc.fields...)
+		testhelper.DiffStringSlice(t, tc.IDStr(), "results",
+			searchResultStrings(results), tc.expResults)
+	}
+}
+
+func TestFamilySearch(t *testing.T) {
+	results := SampleFamily.Search("2 tags")
+	testhelper.DiffStringSlice(t, "SampleFamily.Search", "results",
+		searchResultStrings(results),
+		[]string{
+			SampleFamilyName + "/" + SampleUnit2T + ":notes",
+			SampleFamilyName + "/" + SampleUnitNeg123 + ":notes",
+		})
+}
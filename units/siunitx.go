@@ -0,0 +1,202 @@
+package units
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// siunitxPrefixes maps the SI prefix symbols to the corresponding siunitx
+// macros
+var siunitxPrefixes = map[string]string{
+	"y":  `\yocto`,
+	"z":  `\zepto`,
+	"a":  `\atto`,
+	"f":  `\femto`,
+	"p":  `\pico`,
+	"n":  `\nano`,
+	"µ":  `\micro`,
+	"m":  `\milli`,
+	"c":  `\centi`,
+	"d":  `\deci`,
+	"da": `\deca`,
+	"h":  `\hecto`,
+	"k":  `\kilo`,
+	"M":  `\mega`,
+	"G":  `\giga`,
+	"T":  `\tera`,
+	"P":  `\peta`,
+	"E":  `\exa`,
+	"Z":  `\zetta`,
+	"Y":  `\yotta`,
+}
+
+// siunitxUnits maps unit symbols to the corresponding siunitx macros. The
+// map is keyed by Family name as the same symbol may have different
+// meanings in different families (for instance, "h" for hour and hecto).
+// Note that the tonne is not given as its symbol is shared with the
+// imperial ton.
+var siunitxUnits = map[string]map[string]string{
+	Distance: {
+		"m":  `\metre`,
+		"au": `\astronomicalunit`,
+	},
+	Area: {
+		"m":  `\metre`,
+		"ha": `\hectare`,
+	},
+	Volume: {
+		"m": `\metre`,
+		"l": `\litre`,
+	},
+	Mass: {
+		"g":  `\gram`,
+		"Da": `\dalton`,
+		"eV": `\electronvolt`,
+	},
+	Time: {
+		"s":   `\second`,
+		"min": `\minute`,
+		"h":   `\hour`,
+		"d":   `\day`,
+	},
+	Velocity: {
+		"m": `\metre`,
+		"s": `\second`,
+		"h": `\hour`,
+	},
+	Temperature: {
+		"K":  `\kelvin`,
+		"°C": `\degreeCelsius`,
+	},
+	Energy: {
+		"J":  `\joule`,
+		"W":  `\watt`,
+		"h":  `\hour`,
+		"eV": `\electronvolt`,
+	},
+	Pressure: {
+		"Pa":  `\pascal`,
+		"bar": `\bar`,
+	},
+	Angle: {
+		"rad": `\radian`,
+		"°":   `\degree`,
+		"′":   `\arcminute`,
+		"″":   `\arcsecond`,
+	},
+	Data: {
+		"B":   `\byte`,
+		"bit": `\bit`,
+	},
+}
+
+// siunitxPowers maps powers to the siunitx macros placed after a unit
+var siunitxPowers = map[string]string{
+	"2": `\squared`,
+	"3": `\cubed`,
+}
+
+// siunitxPrefixOrder gives the SI prefix symbols, longest first, so that
+// "da" is tried before "d"
+var siunitxPrefixOrder = func() []string {
+	prefixes := slices.Collect(maps.Keys(siunitxPrefixes))
+	slices.SortFunc(prefixes, func(a, b string) int {
+		if c := cmp.Compare(len(b), len(a)); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a, b)
+	})
+
+	return prefixes
+}()
+
+// siunitxUnitMacro returns the siunitx macros for a single unit symbol from
+// the given table, possibly with an SI prefix. It returns false if there
+// are no macros for the symbol.
+func siunitxUnitMacro(units map[string]string, sym string) (string, bool) {
+	if macro, ok := units[sym]; ok {
+		return macro, true
+	}
+
+	for _, p := range siunitxPrefixOrder {
+		rest, ok := strings.CutPrefix(sym, p)
+		if !ok {
+			continue
+		}
+
+		if macro, ok := units[rest]; ok {
+			return siunitxPrefixes[p] + macro, true
+		}
+	}
+
+	return "", false
+}
+
+// siunitxFactor returns the siunitx macros for one factor of a unit symbol,
+// a unit symbol optionally followed by a superscript power. It returns
+// false if there are no macros for the factor.
+func siunitxFactor(units map[string]string, factor string) (string, bool) {
+	sym, power := factor, ""
+
+	if i := strings.IndexFunc(factor, func(r rune) bool {
+		_, ok := superscriptValues[r]
+		return ok
+	}); i >= 0 {
+		sym = factor[:i]
+		power = convertSymbol(factor[i:],
+			func(s string) string { return s },
+			func(r rune) string { return string(r) })
+	}
+
+	macro, ok := siunitxUnitMacro(units, sym)
+	if !ok {
+		return "", false
+	}
+
+	if power == "" {
+		return macro, true
+	}
+
+	per := ""
+	if rest, found := strings.CutPrefix(power, "-"); found {
+		per, power = `\per`, rest
+	}
+
+	switch {
+	case power == "1":
+		return per + macro, true
+	case siunitxPowers[power] != "":
+		return per + macro + siunitxPowers[power], true
+	}
+
+	return per + macro + `\tothe{` + power + `}`, true
+}
+
+// siunitxUnit returns the symbol of the unit expressed using siunitx
+// macros, for instance, "km·h⁻¹" is given as "\kilo\metre\per\hour". If
+// the symbol cannot be expressed using macros it is given as a literal
+// unit.
+func siunitxUnit(u Unit) string {
+	sym := u.Symbol()
+	if u.f == nil {
+		return latexUnit(sym)
+	}
+
+	units := siunitxUnits[u.f.name]
+
+	var sb strings.Builder
+
+	for factor := range strings.SplitSeq(sym, "·") {
+		macro, ok := siunitxFactor(units, factor)
+		if !ok {
+			return latexUnit(sym)
+		}
+
+		sb.WriteString(macro)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,81 @@
+package units
+
+import (
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestSiunitxUnit(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		u      Unit
+		expStr string
+	}{
+		{
+			ID:     testhelper.MkID("base unit"),
+			u:      GetOrPanic(Distance, "metre"),
+			expStr: `\metre`,
+		},
+		{
+			ID:     testhelper.MkID("prefixed"),
+			u:      GetOrPanic(Distance, "km"),
+			expStr: `\kilo\metre`,
+		},
+		{
+			ID:     testhelper.MkID("two letter prefix"),
+			u:      GetOrPanic(Distance, "dam"),
+			expStr: `\deca\metre`,
+		},
+		{
+			ID:     testhelper.MkID("squared, prefixed"),
+			u:      GetOrPanic(Area, "km²"),
+			expStr: `\kilo\metre\squared`,
+		},
+		{
+			ID:     testhelper.MkID("cubed"),
+			u:      GetOrPanic(Volume, "cubic metre"),
+			expStr: `\metre\cubed`,
+		},
+		{
+			ID:     testhelper.MkID("per"),
+			u:      GetOrPanic(Velocity, "km/h"),
+			expStr: `\kilo\metre\per\hour`,
+		},
+		{
+			ID:     testhelper.MkID("product"),
+			u:      GetOrPanic(Energy, "kWh"),
+			expStr: `\kilo\watt\hour`,
+		},
+		{
+			ID:     testhelper.MkID("non-SI, literal"),
+			u:      GetOrPanic(Area, "square foot"),
+			expStr: `ft^{2}`,
+		},
+		{
+			ID:     testhelper.MkID("ambiguous symbol, literal"),
+			u:      GetOrPanic(Mass, "tonne"),
+			expStr: `t`,
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "siunitx unit",
+			siunitxUnit(tc.u), tc.expStr)
+	}
+}
+
+func TestValUnitLaTeXAndMathML(t *testing.T) {
+	km := GetOrPanic(Distance, "km")
+	degree := GetOrPanic(Angle, "degree")
+
+	testhelper.DiffString(t, "12.5 km", "LaTeX",
+		ValUnit{V: 12.5, U: km}.LaTeX(), `\qty{12.5}{\kilo\metre}`)
+	testhelper.DiffString(t, "12.5 km", "MathML",
+		ValUnit{V: 12.5, U: km}.MathML(),
+		mathMLStart+`<mn>12.5</mn>`+mathMLThinSpan+
+			`<mi mathvariant="normal">km</mi>`+mathMLEnd)
+	testhelper.DiffString(t, "30°", "MathML",
+		ValUnit{V: 30, U: degree}.MathML(),
+		mathMLStart+`<mn>30</mn><mi mathvariant="normal">°</mi>`+mathMLEnd)
+}
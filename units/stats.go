@@ -0,0 +1,208 @@
+package units
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// errNoValues is returned by the statistics functions if they are given no
+// values
+var errNoValues = errors.New("there are no values")
+
+// statsBaseValues checks that the ValUnits are all in the Family of the
+// Unit and returns their values in base units
+func statsBaseValues(vs []ValUnit, u Unit) ([]float64, error) {
+	if err := checkHasFamily(u); err != nil {
+		return nil, err
+	}
+
+	if len(vs) == 0 {
+		return nil, errNoValues
+	}
+
+	if err := checkSameFamily(vs); err != nil {
+		return nil, err
+	}
+
+	if vs[0].U.f != u.f {
+		return nil,
+			fmt.Errorf(
+				"mismatched unit families. Cannot give %s values in %s",
+				vs[0].U.f.name, u.f.name)
+	}
+
+	base := make([]float64, 0, len(vs))
+
+	for _, v := range vs {
+		b, err := convertToBaseUnits(v.V, v.U)
+		if err != nil {
+			return nil, err
+		}
+
+		base = append(base, b)
+	}
+
+	return base, nil
+}
+
+// hasOffsetUnits returns true if any unit of the Family is converted to the
+// base units with a non-zero addition
+func (f *Family) hasOffsetUnits() bool {
+	for _, u := range f.altUnits {
+		if u.hasOffset() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// valUnitFromBase returns the ValUnit in the Unit having the value given in
+// base units
+func valUnitFromBase(base float64, u Unit) (ValUnit, error) {
+	v, err := convertFromBaseUnits(base, u)
+	return ValUnit{V: v, U: u}, err
+}
+
+// Sum returns the sum of the ValUnits, which must all be in the Family of
+// the Unit, given in that Unit. The values may be in different units.
+//
+// Values in a Family having units with different zero points, such as
+// temperatures, cannot be summed; the result would depend on the unit used
+// (10°C + 10°C would be 20°C but 50°F + 50°F is 100°F, which is 37.8°C).
+// Use Mean instead or, to sum temperature differences, the conversion
+// factors of the units.
+//
+// A non-nil error is returned if there are no values, the units are not
+// all in the same Family or the Family has units with offsets.
+func Sum(vs []ValUnit, u Unit) (ValUnit, error) {
+	base, err := statsBaseValues(vs, u)
+	if err != nil {
+		return ValUnit{U: u}, err
+	}
+
+	if u.f.hasOffsetUnits() {
+		return ValUnit{U: u},
+			fmt.Errorf("%s values cannot be summed"+
+				" as the units have different zero points", u.f.name)
+	}
+
+	sum := 0.0
+	for _, b := range base {
+		sum += b
+	}
+
+	return valUnitFromBase(sum, u)
+}
+
+// Mean returns the arithmetic mean of the ValUnits, which must all be in
+// the Family of the Unit, given in that Unit. The values may be in
+// different units; the mean of 50°F and 10°C is 10°C. A non-nil error is
+// returned if there are no values or the units are not all in the same
+// Family.
+func Mean(vs []ValUnit, u Unit) (ValUnit, error) {
+	base, err := statsBaseValues(vs, u)
+	if err != nil {
+		return ValUnit{U: u}, err
+	}
+
+	return valUnitFromBase(mean(base), u)
+}
+
+// mean returns the arithmetic mean of the values
+func mean(vals []float64) float64 {
+	sum := 0.0
+	for _, v := range vals {
+		sum += v
+	}
+
+	return sum / float64(len(vals))
+}
+
+// Min returns the smallest of the ValUnits, which must all be in the Family
+// of the Unit, given in that Unit. A non-nil error is returned if there are
+// no values or the units are not all in the same Family.
+func Min(vs []ValUnit, u Unit) (ValUnit, error) {
+	base, err := statsBaseValues(vs, u)
+	if err != nil {
+		return ValUnit{U: u}, err
+	}
+
+	return valUnitFromBase(slices.Min(base), u)
+}
+
+// Max returns the largest of the ValUnits, which must all be in the Family
+// of the Unit, given in that Unit. A non-nil error is returned if there are
+// no values or the units are not all in the same Family.
+func Max(vs []ValUnit, u Unit) (ValUnit, error) {
+	base, err := statsBaseValues(vs, u)
+	if err != nil {
+		return ValUnit{U: u}, err
+	}
+
+	return valUnitFromBase(slices.Max(base), u)
+}
+
+// Percentile returns the p'th percentile of the ValUnits, which must all be
+// in the Family of the Unit, given in that Unit. The percentile must be
+// between 0 and 100. Where it falls between two values the result is
+// interpolated linearly between them. A non-nil error is returned if there
+// are no values, the units are not all in the same Family or the percentile
+// is out of range.
+func Percentile(vs []ValUnit, p float64, u Unit) (ValUnit, error) {
+	if !(p >= 0 && p <= 100) {
+		return ValUnit{U: u},
+			fmt.Errorf("the percentile (%g) must be between 0 and 100", p)
+	}
+
+	base, err := statsBaseValues(vs, u)
+	if err != nil {
+		return ValUnit{U: u}, err
+	}
+
+	slices.Sort(base)
+
+	rank := p / 100 * float64(len(base)-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+
+	return valUnitFromBase(base[lo]+(base[hi]-base[lo])*(rank-float64(lo)), u)
+}
+
+// Median returns the median of the ValUnits, which must all be in the
+// Family of the Unit, given in that Unit. It is the 50th percentile (see
+// Percentile).
+func Median(vs []ValUnit, u Unit) (ValUnit, error) {
+	return Percentile(vs, 50, u) //nolint:mnd
+}
+
+// StdDev returns the population standard deviation of the ValUnits, which
+// must all be in the Family of the Unit, given in that Unit. Since the
+// standard deviation is a difference between values only the conversion
+// factor of the Unit is applied, not any offsets; so values with a
+// standard deviation of 1°C have a standard deviation of 1.8°F. A non-nil
+// error is returned if there are no values or the units are not all in the
+// same Family.
+func StdDev(vs []ValUnit, u Unit) (ValUnit, error) {
+	base, err := statsBaseValues(vs, u)
+	if err != nil {
+		return ValUnit{U: u}, err
+	}
+
+	if u.convFactor == 0 {
+		return ValUnit{U: u}, errors.New("bad units - a zero conversion factor")
+	}
+
+	m := mean(base)
+	sumSq := 0.0
+
+	for _, b := range base {
+		sumSq += (b - m) * (b - m)
+	}
+
+	sd := math.Sqrt(sumSq / float64(len(base)))
+
+	return ValUnit{V: sd / u.convFactor, U: u}, nil
+}
@@ -0,0 +1,184 @@
+package units
+
+import (
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestStats(t *testing.T) {
+	const epsilon = 1e-9
+
+	temps := []ValUnit{
+		mkVU(temperatureFamily, 50, "F"),
+		mkVU(temperatureFamily, 20, "C"),
+		mkVU(temperatureFamily, 32, "F"),
+		mkVU(temperatureFamily, 303.15, "K"),
+	}
+	degC := temperatureFamily.GetUnitOrPanic("C")
+	degF := temperatureFamily.GetUnitOrPanic("F")
+
+	dists := []ValUnit{
+		mkVU(distanceFamily, 1, "km"),
+		mkVU(distanceFamily, 500, "metre"),
+	}
+	metre := distanceFamily.GetUnitOrPanic("metre")
+
+	type statFunc func([]ValUnit, Unit) (ValUnit, error)
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		f      statFunc
+		vs     []ValUnit
+		u      Unit
+		expVal float64
+	}{
+		{
+			ID:     testhelper.MkID("sum, no offset"),
+			f:      Sum,
+			vs:     dists,
+			u:      metre,
+			expVal: 1500,
+		},
+		{
+			ID: testhelper.MkID("sum, Celsius"),
+			f:  Sum,
+			vs: temps,
+			u:  degC,
+			ExpErr: testhelper.MkExpErr("temperature values cannot be summed" +
+				" as the units have different zero points"),
+		},
+		{
+			ID: testhelper.MkID("sum, Fahrenheit"),
+			f:  Sum,
+			vs: temps,
+			u:  degF,
+			ExpErr: testhelper.MkExpErr("temperature values cannot be summed" +
+				" as the units have different zero points"),
+		},
+		{
+			ID:     testhelper.MkID("mean, Celsius"),
+			f:      Mean,
+			vs:     temps,
+			u:      degC,
+			expVal: 15,
+		},
+		{
+			ID:     testhelper.MkID("mean, Fahrenheit"),
+			f:      Mean,
+			vs:     temps,
+			u:      degF,
+			expVal: 59,
+		},
+		{
+			ID:     testhelper.MkID("min"),
+			f:      Min,
+			vs:     temps,
+			u:      degF,
+			expVal: 32,
+		},
+		{
+			ID:     testhelper.MkID("max"),
+			f:      Max,
+			vs:     temps,
+			u:      degC,
+			expVal: 30,
+		},
+		{
+			ID:     testhelper.MkID("median, even number of values"),
+			f:      Median,
+			vs:     temps,
+			u:      degC,
+			expVal: 15,
+		},
+		{
+			ID:     testhelper.MkID("standard deviation, Celsius"),
+			f:      StdDev,
+			vs:     temps,
+			u:      degC,
+			expVal: 11.180339887498949,
+		},
+		{
+			ID:     testhelper.MkID("standard deviation, Fahrenheit"),
+			f:      StdDev,
+			vs:     temps,
+			u:      degF,
+			expVal: 11.180339887498949 * 1.8,
+		},
+		{
+			ID:     testhelper.MkID("no values"),
+			f:      Mean,
+			u:      degC,
+			ExpErr: testhelper.MkExpErr("there are no values"),
+		},
+		{
+			ID: testhelper.MkID("wrong unit"),
+			f:  Max,
+			vs: dists,
+			u:  degC,
+			ExpErr: testhelper.MkExpErr(
+				"mismatched unit families." +
+					" Cannot give distance values in temperature"),
+		},
+		{
+			ID:     testhelper.MkID("zero unit"),
+			f:      Sum,
+			vs:     dists,
+			ExpErr: testhelper.MkExpErr(`the unit "" has no family`),
+		},
+		{
+			ID:     testhelper.MkID("zero ValUnit"),
+			f:      Mean,
+			vs:     []ValUnit{{}},
+			u:      metre,
+			ExpErr: testhelper.MkExpErr(`value 0: the unit "" has no family`),
+		},
+	}
+
+	for _, tc := range testCases {
+		v, err := tc.f(tc.vs, tc.u)
+		if testhelper.CheckExpErr(t, err, tc) && err == nil {
+			testhelper.DiffFloat(t, tc.IDStr(), "value", v.V, tc.expVal, epsilon)
+			testhelper.DiffString(t, tc.IDStr(), "unit", v.U.id, tc.u.id)
+		}
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	const epsilon = 1e-9
+
+	vs := []ValUnit{
+		mkVU(timeFamily, 4, "second"),
+		mkVU(timeFamily, 1, "minute"),
+		mkVU(timeFamily, 1000, "msec"),
+		mkVU(timeFamily, 2, "second"),
+		mkVU(timeFamily, 3000, "msec"),
+	}
+	sec := timeFamily.GetUnitOrPanic("second")
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		p      float64
+		expVal float64
+	}{
+		{ID: testhelper.MkID("0th"), p: 0, expVal: 1},
+		{ID: testhelper.MkID("median"), p: 50, expVal: 3},
+		{ID: testhelper.MkID("interpolated"), p: 90, expVal: 4 + 0.6*56},
+		{ID: testhelper.MkID("100th"), p: 100, expVal: 60},
+		{
+			ID: testhelper.MkID("out of range"),
+			p:  101,
+			ExpErr: testhelper.MkExpErr(
+				"the percentile (101) must be between 0 and 100"),
+		},
+	}
+
+	for _, tc := range testCases {
+		v, err := Percentile(vs, tc.p, sec)
+		if testhelper.CheckExpErr(t, err, tc) && err == nil {
+			testhelper.DiffFloat(t, tc.IDStr(), "value", v.V, tc.expVal, epsilon)
+		}
+	}
+}
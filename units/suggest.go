@@ -0,0 +1,183 @@
+package units
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// maxErrSuggestions is the largest number of suggestions that will be
+// reported in an UnknownUnitError
+const maxErrSuggestions = 3
+
+// Suggestion records a unit name (or alias) that is similar to some
+// unrecognised name together with the canonical name of the Unit it refers
+// to and the edit distance between the names. The lower the distance, the
+// better the match.
+type Suggestion struct {
+	Name     string
+	UnitID   string
+	Distance int
+}
+
+// UnknownUnitError is the error returned by Family.GetUnit when no unit can
+// be found with the given name. It records the names of some similarly
+// named units which the caller might have meant; this may be empty if no
+// names are sufficiently similar.
+type UnknownUnitError struct {
+	Family      *Family
+	Name        string
+	Suggestions []Suggestion
+}
+
+// Error returns a string describing the error, including any suggestions
+func (e *UnknownUnitError) Error() string {
+	msg := fmt.Sprintf("there is no %s called %q", e.Family.description, e.Name)
+
+	if len(e.Suggestions) > 0 {
+		names := make([]string, 0, len(e.Suggestions))
+		for _, s := range e.Suggestions {
+			names = append(names, fmt.Sprintf("%q", s.Name))
+		}
+
+		msg += ", did you mean: " + strings.Join(names, " or ")
+	}
+
+	return msg
+}
+
+// suggestionKey returns the string used to compare unit names when looking
+// for suggestions. It is lower-cased and has any spaces, hyphens and
+// underscores removed.
+func suggestionKey(s string) string {
+	var sb strings.Builder
+
+	for _, r := range s {
+		if unicode.IsSpace(r) || r == '-' || r == '_' {
+			continue
+		}
+
+		sb.WriteRune(unicode.ToLower(r))
+	}
+
+	return sb.String()
+}
+
+// editDistance returns the optimal string alignment distance between the two
+// strings. This is the number of single-character insertions, deletions,
+// substitutions or transpositions of adjacent characters needed to turn one
+// string into the other.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				curr[j] = min(curr[j], prev2[j-2]+1)
+			}
+		}
+
+		prev2, prev, curr = prev, curr, prev2
+	}
+
+	return prev[len(rb)]
+}
+
+// maxSuggestionDistance returns the largest edit distance at which a name
+// will be suggested as an alternative to the given key. It allows more
+// differences for longer names.
+func maxSuggestionDistance(key string) int {
+	const charsPerEdit = 4
+
+	return 1 + len([]rune(key))/charsPerEdit
+}
+
+// Suggestions returns the names and aliases of units in the family which are
+// similar to the given name. The comparison ignores case, whitespace,
+// hyphens and underscores. The results are sorted with the closest matches
+// first; matches at the same distance are sorted by name.
+func (f *Family) Suggestions(name string) []Suggestion {
+	key := suggestionKey(name)
+	maxDist := maxSuggestionDistance(key)
+
+	rval := []Suggestion{}
+
+	addIfClose := func(candidate, uID string) {
+		dist := editDistance(key, suggestionKey(candidate))
+		if dist <= maxDist {
+			rval = append(rval,
+				Suggestion{Name: candidate, UnitID: uID, Distance: dist})
+		}
+	}
+
+	for uID := range f.altUnits {
+		addIfClose(uID, uID)
+	}
+
+	for alias, uID := range f.unitAliases {
+		addIfClose(alias, uID)
+	}
+
+	slices.SortFunc(rval, func(a, b Suggestion) int {
+		if a.Distance != b.Distance {
+			return a.Distance - b.Distance
+		}
+
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return rval
+}
+
+// bestSuggestions returns the best few suggestions for the given name, with
+// at most one suggestion for each unit.
+func (f *Family) bestSuggestions(name string) []Suggestion {
+	rval := []Suggestion{}
+	seen := map[string]bool{}
+
+	for _, s := range f.Suggestions(name) {
+		if seen[s.UnitID] {
+			continue
+		}
+
+		seen[s.UnitID] = true
+
+		rval = append(rval, s)
+		if len(rval) == maxErrSuggestions {
+			break
+		}
+	}
+
+	return rval
+}
+
+// addSuggestions adds the best suggestions to the error if it is an
+// *UnknownUnitError. The suggestions are only found when the lookup has
+// finally failed, as finding them is much slower than the lookup itself.
+func (f *Family) addSuggestions(err error) error {
+	var uue *UnknownUnitError
+	if errors.As(err, &uue) && uue.Suggestions == nil {
+		uue.Suggestions = f.bestSuggestions(uue.Name)
+	}
+
+	return err
+}
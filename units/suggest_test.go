@@ -0,0 +1,145 @@
+package units
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestEditDistance(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		a, b    string
+		expDist int
+	}{
+		{ID: testhelper.MkID("equal"), a: "metre", b: "metre", expDist: 0},
+		{ID: testhelper.MkID("empty"), a: "", b: "abc", expDist: 3},
+		{ID: testhelper.MkID("transposed"), a: "metre", b: "meter", expDist: 1},
+		{ID: testhelper.MkID("substituted"), a: "inch", b: "itch", expDist: 1},
+		{ID: testhelper.MkID("deleted"), a: "inches", b: "inchs", expDist: 1},
+		{ID: testhelper.MkID("multi-byte"), a: "µm", b: "um", expDist: 1},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffInt(t, tc.IDStr(), "distance",
+			editDistance(tc.a, tc.b), tc.expDist)
+	}
+}
+
+func TestSuggestions(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		name     string
+		expNames []string
+	}{
+		{
+			ID:       testhelper.MkID("case and spacing ignored"),
+			name:     "Sample-a",
+			expNames: []string{SampleUnitAAlias, SampleUnitBase},
+		},
+		{
+			ID:   testhelper.MkID("misspelt"),
+			name: "smaple",
+			expNames: []string{
+				SampleUnitBase,
+				SampleUnitAAlias,
+				SampleUnitBaseAlias,
+			},
+		},
+		{
+			ID:       testhelper.MkID("nothing close"),
+			name:     "nonesuch",
+			expNames: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		names := []string{}
+		for _, s := range SampleFamily.Suggestions(tc.name) {
+			names = append(names, s.Name)
+		}
+
+		testhelper.DiffStringSlice(t, tc.IDStr(), "suggestions",
+			names, tc.expNames)
+	}
+}
+
+func TestUnknownUnitError(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		name           string
+		strict         bool
+		expSuggestions []string
+	}{
+		{
+			ID: testhelper.MkID("with suggestions"),
+			ExpErr: testhelper.MkExpErr(
+				`there is no unit of test called "sampel A",` +
+					` did you mean: "sample A" or "sample"`),
+			name:           "sampel A",
+			expSuggestions: []string{SampleUnitAAlias, SampleUnitBase},
+		},
+		{
+			ID: testhelper.MkID("no suggestions"),
+			ExpErr: testhelper.MkExpErr(
+				`there is no unit of test called "nonesuch"`),
+			name:           "nonesuch",
+			expSuggestions: []string{},
+		},
+		{
+			ID: testhelper.MkID("strict, with suggestions"),
+			ExpErr: testhelper.MkExpErr(
+				`there is no unit of test called "sampel A",` +
+					` did you mean: "sample A" or "sample"`),
+			name:           "sampel A",
+			strict:         true,
+			expSuggestions: []string{SampleUnitAAlias, SampleUnitBase},
+		},
+	}
+
+	for _, tc := range testCases {
+		get := SampleFamily.GetUnit
+		if tc.strict {
+			get = SampleFamily.GetUnitStrict
+		}
+
+		_, err := get(tc.name)
+		testhelper.CheckExpErr(t, err, tc)
+
+		var uue *UnknownUnitError
+		if !errors.As(err, &uue) {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: the error should be an *UnknownUnitError\n")
+
+			continue
+		}
+
+		testhelper.DiffString(t, tc.IDStr(), "Name", uue.Name, tc.name)
+
+		names := []string{}
+		for _, s := range uue.Suggestions {
+			names = append(names, s.Name)
+		}
+
+		testhelper.DiffStringSlice(t, tc.IDStr(), "Suggestions",
+			names, tc.expSuggestions)
+	}
+}
+
+func TestSuggestionsOnlyOnFailure(t *testing.T) {
+	for _, name := range []string{"sampel A", "nonesuch"} {
+		_, err := SampleFamily.getUnit(name)
+
+		var uue *UnknownUnitError
+		if !errors.As(err, &uue) {
+			t.Errorf("%s: the error should be an *UnknownUnitError", name)
+			continue
+		}
+
+		if uue.Suggestions != nil {
+			t.Errorf("%s: suggestions should not be found by getUnit", name)
+		}
+	}
+}
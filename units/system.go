@@ -0,0 +1,497 @@
+package units
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+// UnitPref records a preferred Unit in a System. The MinVal gives the
+// smallest (absolute) value, expressed in this Unit, for which the Unit is
+// preferred over the smaller units before it.
+type UnitPref struct {
+	UnitID string
+	MinVal float64
+}
+
+// System represents a system of units such as the SI or US customary
+// units. For each Family it covers it gives an ordered list of preferred
+// units, from smallest to largest, so that values can be displayed in the
+// units conventionally used in that system.
+//
+// To get a System value you should use the [GetSystem] func (or
+// [GetSystemOrPanic]) passing a System name chosen ideally from the
+// constant values provided. You can create your own System using
+// [NewSystem].
+type System struct {
+	name        string
+	description string
+	prefs       map[string][]UnitPref
+}
+
+// Name returns the name of the System
+func (s *System) Name() string {
+	return s.name
+}
+
+// Description returns the description of the System
+func (s *System) Description() string {
+	return s.description
+}
+
+// String returns a string representation of the System
+func (s *System) String() string {
+	return fmt.Sprintf("%s: %s. Covers %d unit families.",
+		s.name, s.description, len(s.prefs))
+}
+
+// FamilyNames returns the names of the families for which the System has
+// preferred units. The names are sorted.
+func (s *System) FamilyNames() []string {
+	names := make([]string, 0, len(s.prefs))
+	for fName := range s.prefs {
+		names = append(names, fName)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// Units returns the preferred Units for the Family, from smallest to
+// largest. It returns a non-nil error if the System has no preferred units
+// for the Family.
+func (s *System) Units(f *Family) ([]Unit, error) {
+	if f == nil {
+		return nil, errors.New("the Family must not be nil")
+	}
+
+	prefs, ok := s.prefs[f.name]
+	if !ok {
+		return nil, fmt.Errorf("the %s system has no preferred units of %s",
+			s.name, f.name)
+	}
+
+	rval := make([]Unit, 0, len(prefs))
+
+	for _, p := range prefs {
+		u, err := f.GetUnitStrict(p.UnitID)
+		if err != nil {
+			return nil, err
+		}
+
+		rval = append(rval, u)
+	}
+
+	return rval, nil
+}
+
+// PreferredUnit returns the Unit that the System prefers for displaying the
+// given value. This is the largest of the preferred units for which the
+// value, once converted, is at least the preferred minimum value; if the
+// value is smaller than all the minimum values the smallest unit is
+// chosen. It returns a non-nil error if the System has no preferred units
+// for the Family of the value or the value cannot be converted.
+func (s *System) PreferredUnit(vu ValUnit) (Unit, error) {
+	if vu.U.f == nil {
+		return Unit{}, fmt.Errorf("the unit %q has no family", vu.U.id)
+	}
+
+	units, err := s.Units(vu.U.f)
+	if err != nil {
+		return Unit{}, err
+	}
+
+	prefs := s.prefs[vu.U.f.name]
+	rval := units[0]
+
+	for i, u := range units {
+		cv, err := vu.Convert(u)
+		if err != nil {
+			return Unit{}, err
+		}
+
+		if math.Abs(cv.V) >= prefs[i].MinVal {
+			rval = u
+		}
+	}
+
+	return rval, nil
+}
+
+// Convert converts the value into the Unit that the System prefers for
+// it. See PreferredUnit for how the Unit is chosen.
+func (s *System) Convert(vu ValUnit) (ValUnit, error) {
+	u, err := s.PreferredUnit(vu)
+	if err != nil {
+		return vu, err
+	}
+
+	return vu.Convert(u)
+}
+
+// ConvertOrPanic calls Convert and panics if the error is non-nil,
+// otherwise it returns the converted value.
+func (s *System) ConvertOrPanic(vu ValUnit) ValUnit {
+	cv, err := s.Convert(vu)
+	if err != nil {
+		panic(err)
+	}
+
+	return cv
+}
+
+// NewSystem creates a new System with the given preferred units. The prefs
+// map is keyed by Family name (which may be an alias) and each entry must
+// give the canonical names of units in that Family ordered from smallest
+// to largest. A non-nil error is returned if the preferences are invalid.
+func NewSystem(name, desc string, prefs map[string][]UnitPref) (*System,
+	error,
+) {
+	s := &System{
+		name:        name,
+		description: desc,
+		prefs:       map[string][]UnitPref{},
+	}
+
+	errs := []error{}
+
+	for fName, fPrefs := range prefs {
+		f, err := GetFamily(fName)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if err := checkUnitPrefs(f, fPrefs); err != nil {
+			errs = append(errs,
+				fmt.Errorf("system %q: %w", name, err))
+
+			continue
+		}
+
+		s.prefs[f.name] = slices.Clone(fPrefs)
+	}
+
+	return s, errors.Join(errs...)
+}
+
+// checkUnitPrefs checks that the preferences are valid for the Family
+func checkUnitPrefs(f *Family, prefs []UnitPref) error {
+	if len(prefs) == 0 {
+		return fmt.Errorf("no preferred units of %s are given", f.name)
+	}
+
+	var prevFactor float64
+
+	for i, p := range prefs {
+		u, err := f.GetUnitStrict(p.UnitID)
+		if err != nil {
+			return err
+		}
+
+		if i > 0 && math.Abs(u.convFactor) <= prevFactor {
+			return fmt.Errorf(
+				"the preferred units of %s are not in order of size: %q",
+				f.name, p.UnitID)
+		}
+
+		prevFactor = math.Abs(u.convFactor)
+	}
+
+	return nil
+}
+
+// These System name constants should be used when retrieving unit systems
+// (using the GetSystem or GetSystemOrPanic funcs).
+const (
+	SystemSI          = "SI"
+	SystemMetric      = "metric"
+	SystemImperial    = "imperial"
+	SystemUSCustomary = "US customary"
+	SystemUK          = "UK"
+)
+
+// These preferences are shared by all the standard systems
+var (
+	timePrefs = []UnitPref{
+		{bunTime, 0},
+		{"minute", 1},
+		{"hour", 1},
+		{"day", 1},
+		{"week", 1},
+		{"Gregorian year", 1},
+	}
+	dataPrefs = []UnitPref{
+		{bunData, 0},
+		{"KB", 1},
+		{"MB", 1},
+		{"GB", 1},
+		{"TB", 1},
+		{"PB", 1},
+	}
+	dimensionlessPrefs = []UnitPref{
+		{bunNumeric, 0},
+	}
+	degreePrefs = []UnitPref{
+		{"second", 0},
+		{"minute", 1},
+		{"degree", 1},
+	}
+)
+
+// These are the preferences for each of the standard systems. Note that the
+// SI preferences include the non-SI units which the SI brochure accepts for
+// use with the SI (the minute, hour, day, litre and tonne) and that the
+// metric system differs from this by allowing other commonly used non-SI
+// units.
+var (
+	siPrefs = map[string][]UnitPref{
+		Distance: {
+			{"mm", 0},
+			{"cm", 1},
+			{bunDistance, 1},
+			{"km", 1},
+		},
+		Area: {
+			{bunArea, 0},
+			{"square kilometre", 1},
+		},
+		Volume: {
+			{"ml", 0},
+			{"litre", 1},
+			{bunVolume, 1},
+		},
+		Mass: {
+			{"mg", 0},
+			{bunMass, 1},
+			{"kg", 1},
+			{"tonne", 1},
+		},
+		Temperature: {{"K", 0}},
+		Velocity:    {{bunVelocity, 0}},
+		Pressure:    {{bunPressure, 0}, {"kPa", 1}, {"MPa", 1}},
+		Energy:      {{bunEnergy, 0}, {"kJ", 1}, {"MJ", 1}, {"GJ", 1}},
+		Angle:       {{"milliradian", 0}, {bunAngle, 1}},
+		Time: {
+			{"msec", 0},
+			{bunTime, 1},
+			{"minute", 1},
+			{"hour", 1},
+			{"day", 1},
+		},
+		Data:          dataPrefs,
+		Dimensionless: dimensionlessPrefs,
+	}
+	metricPrefs = map[string][]UnitPref{
+		Distance: {
+			{"mm", 0},
+			{"cm", 1},
+			{bunDistance, 1},
+			{"km", 1},
+		},
+		Area: {
+			{bunArea, 0},
+			{"hectare", 1},
+			{"square kilometre", 1},
+		},
+		Volume: {
+			{"ml", 0},
+			{"litre", 1},
+			{bunVolume, 1},
+		},
+		Mass: {
+			{"mg", 0},
+			{bunMass, 1},
+			{"kg", 1},
+			{"tonne", 1},
+		},
+		Temperature:   {{bunTemp, 0}},
+		Velocity:      {{"kilometre/hour", 0}},
+		Pressure:      {{"hPa", 0}, {"bar", 1}},
+		Energy:        {{bunEnergy, 0}, {"kJ", 1}, {"kWh", 1}},
+		Angle:         degreePrefs,
+		Time:          timePrefs,
+		Data:          dataPrefs,
+		Dimensionless: dimensionlessPrefs,
+	}
+	imperialPrefs = map[string][]UnitPref{
+		Distance: {
+			{"inch", 0},
+			{"foot", 1},
+			{"yard", 1},
+			{"mile", 1},
+		},
+		Area: {
+			{"square foot", 0},
+			{"square yard", 1},
+			{"acre", 1},
+			{"square mile", 1},
+		},
+		Volume: {
+			{"fluid-ounce", 0},
+			{"pint", 1},
+			{"gallon", 1},
+		},
+		Mass: {
+			{"ounce", 0},
+			{"pound", 1},
+			{"stone", 1},
+			{"imperial-ton", 1},
+		},
+		Temperature:   {{"F", 0}},
+		Velocity:      {{"mile/hour", 0}},
+		Pressure:      {{"psi", 0}},
+		Energy:        {{"BTU", 0}, {"therm", 1}},
+		Angle:         degreePrefs,
+		Time:          timePrefs,
+		Data:          dataPrefs,
+		Dimensionless: dimensionlessPrefs,
+	}
+	usCustomaryPrefs = map[string][]UnitPref{
+		Distance: {
+			{"inch", 0},
+			{"foot", 1},
+			{"yard", 1},
+			{"mile", 1},
+		},
+		Area: {
+			{"square foot", 0},
+			{"square yard", 1},
+			{"acre", 1},
+			{"square mile", 1},
+		},
+		Volume: {
+			{"US-fluid-ounce", 0},
+			{"US-cup", 1},
+			{"US-pint", 1},
+			{"US-quart", 1},
+			{"US-gallon", 1},
+		},
+		Mass: {
+			{"ounce", 0},
+			{"pound", 1},
+			{"short-ton", 1},
+		},
+		Temperature:   {{"F", 0}},
+		Velocity:      {{"mile/hour", 0}},
+		Pressure:      {{"psi", 0}},
+		Energy:        {{"BTU", 0}, {"therm", 1}},
+		Angle:         degreePrefs,
+		Time:          timePrefs,
+		Data:          dataPrefs,
+		Dimensionless: dimensionlessPrefs,
+	}
+	ukPrefs = map[string][]UnitPref{
+		Distance: {
+			{"inch", 0},
+			{"foot", 1},
+			{"yard", 1},
+			{"mile", 1},
+		},
+		Area: {
+			{"square foot", 0},
+			{"acre", 1},
+			{"square mile", 1},
+		},
+		Volume: {
+			{"ml", 0},
+			{"pint", 1},
+			{"litre", 1},
+		},
+		Mass: {
+			{"gram", 0},
+			{"kg", 1},
+			{"tonne", 1},
+		},
+		Temperature:   {{bunTemp, 0}},
+		Velocity:      {{"mile/hour", 0}},
+		Pressure:      {{"psi", 0}},
+		Energy:        {{"kcal", 0}, {"kWh", 1}},
+		Angle:         degreePrefs,
+		Time:          timePrefs,
+		Data:          dataPrefs,
+		Dimensionless: dimensionlessPrefs,
+	}
+)
+
+// mustMakeSystem creates a new System, panicking if the preferences are
+// invalid.
+func mustMakeSystem(name, desc string, prefs map[string][]UnitPref) *System {
+	s, err := NewSystem(name, desc, prefs)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// unitSystems holds the standard Systems. It is populated in the init func
+// as the unit families must be set up before the preferences can be checked.
+var unitSystems = map[string]*System{}
+
+func init() {
+	for _, s := range []*System{
+		mustMakeSystem(SystemSI,
+			"the International System of Units, together with the"+
+				" non-SI units accepted for use with the SI"+
+				" (such as the minute, litre and tonne)",
+			siPrefs),
+		mustMakeSystem(SystemMetric,
+			"the metric system, including commonly used non-SI units",
+			metricPrefs),
+		mustMakeSystem(SystemImperial,
+			"the British imperial system of units",
+			imperialPrefs),
+		mustMakeSystem(SystemUSCustomary,
+			"the United States customary system of units",
+			usCustomaryPrefs),
+		mustMakeSystem(SystemUK,
+			"the mixture of metric and imperial units in everyday UK use",
+			ukPrefs),
+	} {
+		unitSystems[s.name] = s
+	}
+}
+
+// GetSystem returns the named System. It returns a non-nil error if the
+// System name is not found. The name is matched regardless of case.
+func GetSystem(name string) (*System, error) {
+	if s, ok := unitSystems[name]; ok {
+		return s, nil
+	}
+
+	for sName, s := range unitSystems {
+		if strings.EqualFold(sName, name) {
+			return s, nil
+		}
+	}
+
+	return nil, fmt.Errorf("there is no unit system called %q", name)
+}
+
+// GetSystemOrPanic returns the named System. It panics if the System name
+// is not found.
+func GetSystemOrPanic(name string) *System {
+	s, err := GetSystem(name)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// GetSystemNames returns a slice holding the names of the standard
+// Systems. Note that the slice is not sorted and the order of elements may
+// vary.
+func GetSystemNames() []string {
+	names := make([]string, 0, len(unitSystems))
+
+	for name := range unitSystems {
+		names = append(names, name)
+	}
+
+	return names
+}
@@ -0,0 +1,211 @@
+package units
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestGetSystem(t *testing.T) {
+	const badSystemName = "no-such-system"
+
+	badSystemNameErr := `there is no unit system called "` + badSystemName + `"`
+	testCases := []struct {
+		testhelper.ID
+		name string
+		testhelper.ExpPanic
+		testhelper.ExpErr
+	}{
+		{
+			ID:       testhelper.MkID("bad-name"),
+			ExpPanic: testhelper.MkExpPanic(badSystemNameErr),
+			ExpErr:   testhelper.MkExpErr(badSystemNameErr),
+			name:     badSystemName,
+		},
+		{
+			ID:   testhelper.MkID("good-name"),
+			name: SystemImperial,
+		},
+		{
+			ID:   testhelper.MkID("good-name, different case"),
+			name: "us CUSTOMARY",
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := GetSystem(tc.name)
+		testhelper.CheckExpErr(t, err, tc)
+
+		panicked, panicVal := testhelper.PanicSafe(func() {
+			GetSystemOrPanic(tc.name)
+		})
+		testhelper.CheckExpPanicError(t, panicked, panicVal, tc)
+	}
+}
+
+func TestSystemsCoverAllFamilies(t *testing.T) {
+	expNames := GetFamilyNames()
+	sort.Strings(expNames)
+
+	for _, sName := range GetSystemNames() {
+		s := GetSystemOrPanic(sName)
+		testhelper.DiffStringSlice(t, sName, "families",
+			s.FamilyNames(), expNames)
+	}
+}
+
+func TestSystemConvert(t *testing.T) {
+	metre := GetOrPanic(Distance, "metre")
+	kg := GetOrPanic(Mass, "kg")
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		system  string
+		vu      ValUnit
+		expUnit string
+		expVal  float64
+	}{
+		{
+			ID:      testhelper.MkID("SI, small distance"),
+			system:  SystemSI,
+			vu:      ValUnit{V: 0.3, U: metre},
+			expUnit: "cm",
+			expVal:  30,
+		},
+		{
+			ID:      testhelper.MkID("SI, large distance"),
+			system:  SystemSI,
+			vu:      ValUnit{V: 1500, U: metre},
+			expUnit: "km",
+			expVal:  1.5,
+		},
+		{
+			ID:      testhelper.MkID("SI, zero"),
+			system:  SystemSI,
+			vu:      ValUnit{V: 0, U: metre},
+			expUnit: "mm",
+			expVal:  0,
+		},
+		{
+			ID:      testhelper.MkID("SI, temperature"),
+			system:  SystemSI,
+			vu:      ValUnit{V: 20, U: degCUnit},
+			expUnit: "K",
+			expVal:  293.15,
+		},
+		{
+			ID:      testhelper.MkID("imperial, negative distance"),
+			system:  SystemImperial,
+			vu:      ValUnit{V: -3000, U: metre},
+			expUnit: "mile",
+			expVal:  -3000 / mileToMetre,
+		},
+		{
+			ID:      testhelper.MkID("US, mass"),
+			system:  SystemUSCustomary,
+			vu:      ValUnit{V: 70, U: kg},
+			expUnit: "pound",
+			expVal:  70000 / poundToGram,
+		},
+		{
+			ID:      testhelper.MkID("UK, mass"),
+			system:  SystemUK,
+			vu:      ValUnit{V: 70, U: kg},
+			expUnit: "kg",
+			expVal:  70,
+		},
+		{
+			ID:     testhelper.MkID("uncovered family"),
+			system: SystemSI,
+			vu: ValUnit{
+				V: 1,
+				U: SampleFamily.GetUnitOrPanic(SampleUnitBase),
+			},
+			ExpErr: testhelper.MkExpErr(
+				"the SI system has no preferred units of " + SampleFamilyName),
+		},
+		{
+			ID:     testhelper.MkID("zero ValUnit"),
+			system: SystemSI,
+			ExpErr: testhelper.MkExpErr(`the unit "" has no family`),
+		},
+	}
+
+	for _, tc := range testCases {
+		s := GetSystemOrPanic(tc.system)
+
+		cv, err := s.Convert(tc.vu)
+		if !testhelper.CheckExpErr(t, err, tc) || err != nil {
+			continue
+		}
+
+		testhelper.DiffString(t, tc.IDStr(), "unit", cv.U.ID(), tc.expUnit)
+		testhelper.DiffFloat(t, tc.IDStr(), "value", cv.V, tc.expVal, 1e-9)
+	}
+}
+
+func TestNewSystem(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		prefs map[string][]UnitPref
+	}{
+		{
+			ID: testhelper.MkID("good, with family alias"),
+			prefs: map[string][]UnitPref{
+				"length": {{"metre", 0}, {"km", 1}},
+			},
+		},
+		{
+			ID: testhelper.MkID("bad family"),
+			prefs: map[string][]UnitPref{
+				"nonesuch": {{"metre", 0}},
+			},
+			ExpErr: testhelper.MkExpErr(
+				`there is no unit family called "nonesuch"`),
+		},
+		{
+			ID: testhelper.MkID("bad unit"),
+			prefs: map[string][]UnitPref{
+				Distance: {{"nonesuch", 0}},
+			},
+			ExpErr: testhelper.MkExpErr(
+				`there is no unit of distance called "nonesuch"`),
+		},
+		{
+			ID: testhelper.MkID("bad order"),
+			prefs: map[string][]UnitPref{
+				Distance: {{"km", 0}, {"metre", 1}},
+			},
+			ExpErr: testhelper.MkExpErr(
+				`the preferred units of distance are not in order of size`),
+		},
+		{
+			ID: testhelper.MkID("no units"),
+			prefs: map[string][]UnitPref{
+				Distance: {},
+			},
+			ExpErr: testhelper.MkExpErr(
+				`no preferred units of distance are given`),
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := NewSystem("test", "a test system", tc.prefs)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
+
+func TestSystemUnitsNilFamily(t *testing.T) {
+	_, err := GetSystemOrPanic(SystemSI).Units(nil)
+	testhelper.CheckExpErr(t, err,
+		struct {
+			testhelper.ID
+			testhelper.ExpErr
+		}{
+			ID:     testhelper.MkID("nil Family"),
+			ExpErr: testhelper.MkExpErr("the Family must not be nil"),
+		})
+}
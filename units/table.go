@@ -0,0 +1,220 @@
+package units
+
+import (
+	"cmp"
+	"fmt"
+	"html"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// TableFormat describes the markup used when writing a conversion table
+type TableFormat int
+
+// These are the available table formats
+const (
+	TableMarkdown TableFormat = iota // a GitHub-flavoured Markdown table
+	TableLaTeX                       // a LaTeX tabular using siunitx
+	TableHTML                        // an HTML table element
+)
+
+// tableFormatNames maps the TableFormat values to a descriptive name
+var tableFormatNames = map[TableFormat]string{
+	TableMarkdown: "Markdown",
+	TableLaTeX:    "LaTeX",
+	TableHTML:     "HTML",
+}
+
+// String returns the name of the TableFormat
+func (tf TableFormat) String() string {
+	if s, ok := tableFormatNames[tf]; ok {
+		return s
+	}
+
+	return "TableFormat(" + strconv.Itoa(int(tf)) + ")"
+}
+
+// tableRow holds the cells of one row of a conversion table
+type tableRow struct {
+	u       Unit
+	factor  string
+	formula string
+}
+
+// tableHeadings returns the column headings of the conversion table for the
+// Family
+func (f *Family) tableHeadings() []string {
+	return []string{
+		"Unit",
+		"Symbol",
+		"Factor to " + f.baseUnitName,
+		"Conversion from " + f.baseUnitName,
+	}
+}
+
+// tableRows returns the rows of the conversion table for the Family, in
+// order of the conversion factor and then the unit name
+func (f *Family) tableRows() []tableRow {
+	units := f.GetUnits()
+	slices.SortFunc(units, func(a, b Unit) int {
+		if c := cmp.Compare(a.convFactor, b.convFactor); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.id, b.id)
+	})
+
+	rows := make([]tableRow, 0, len(units))
+	for _, u := range units {
+		rows = append(rows, tableRow{
+			u:       u,
+			factor:  strconv.FormatFloat(u.convFactor, 'g', -1, 64),
+			formula: u.ConversionFormula(),
+		})
+	}
+
+	return rows
+}
+
+// latexText escapes any characters with a special meaning in LaTeX
+func latexText(s string) string {
+	var sb strings.Builder
+
+	for _, r := range s {
+		if esc, ok := latexSpecialChars[r]; ok {
+			sb.WriteString(esc)
+			continue
+		}
+
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
+
+// markdownText escapes any characters which would break a Markdown table
+// cell
+var markdownText = strings.NewReplacer(
+	`\`, `\\`,
+	"|", `\|`,
+	"*", `\*`,
+	"_", `\_`,
+).Replace
+
+// writeMarkdownTable writes the conversion table as a Markdown table
+func (f *Family) writeMarkdownTable(w io.Writer) error {
+	var sb strings.Builder
+
+	sb.WriteString("|")
+
+	for _, h := range f.tableHeadings() {
+		sb.WriteString(" " + markdownText(h) + " |")
+	}
+
+	sb.WriteString("\n| --- | --- | ---: | --- |\n")
+
+	for _, r := range f.tableRows() {
+		fmt.Fprintf(&sb, "| %s | %s | %s | %s |\n",
+			markdownText(r.u.id),
+			markdownText(r.u.Symbol()),
+			r.factor,
+			markdownText(r.formula))
+	}
+
+	_, err := io.WriteString(w, sb.String())
+
+	return err
+}
+
+// writeLaTeXTable writes the conversion table as a LaTeX tabular. The
+// symbols and factors are given using the siunitx package.
+func (f *Family) writeLaTeXTable(w io.Writer) error {
+	var sb strings.Builder
+
+	headings := make([]string, 0, len(f.tableHeadings()))
+	for _, h := range f.tableHeadings() {
+		headings = append(headings, latexText(h))
+	}
+
+	sb.WriteString(`\begin{tabular}{llrl}` + "\n")
+	sb.WriteString(`\hline` + "\n")
+	sb.WriteString(strings.Join(headings, " & ") + ` \\` + "\n")
+	sb.WriteString(`\hline` + "\n")
+
+	for _, r := range f.tableRows() {
+		sym := ""
+		if r.u.Symbol() != "" {
+			sym = `\unit{` + siunitxUnit(r.u) + `}`
+		}
+
+		fmt.Fprintf(&sb, "%s & %s & \\num{%s} & %s \\\\\n",
+			latexText(r.u.id), sym, r.factor, latexText(r.formula))
+	}
+
+	sb.WriteString(`\hline` + "\n")
+	sb.WriteString(`\end{tabular}` + "\n")
+
+	_, err := io.WriteString(w, sb.String())
+
+	return err
+}
+
+// writeHTMLTable writes the conversion table as an HTML table element
+func (f *Family) writeHTMLTable(w io.Writer) error {
+	var sb strings.Builder
+
+	sb.WriteString("<table>\n<thead>\n<tr>")
+
+	for _, h := range f.tableHeadings() {
+		sb.WriteString("<th>" + html.EscapeString(h) + "</th>")
+	}
+
+	sb.WriteString("</tr>\n</thead>\n<tbody>\n")
+
+	for _, r := range f.tableRows() {
+		fmt.Fprintf(&sb, "<tr><td>%s</td><td>%s</td><td>%s</td><td>%s</td></tr>\n",
+			html.EscapeString(r.u.id),
+			htmlUnit(r.u.Symbol()),
+			r.factor,
+			html.EscapeString(r.formula))
+	}
+
+	sb.WriteString("</tbody>\n</table>\n")
+
+	_, err := io.WriteString(w, sb.String())
+
+	return err
+}
+
+// WriteConversionTable writes a table of the units in the Family to the
+// Writer in the given format. Each row shows the unit name, its symbol (see
+// Unit.Symbol), the factor by which a value in the unit is multiplied to
+// give base units and the formula for converting from base units (see
+// Unit.ConversionFormula). The rows are in order of the conversion factor.
+//
+// A non-nil error is returned if the format is not known or the Writer
+// fails.
+func (f *Family) WriteConversionTable(w io.Writer, tf TableFormat) error {
+	switch tf {
+	case TableMarkdown:
+		return f.writeMarkdownTable(w)
+	case TableLaTeX:
+		return f.writeLaTeXTable(w)
+	case TableHTML:
+		return f.writeHTMLTable(w)
+	}
+
+	return fmt.Errorf("there is no table format %s", tf)
+}
+
+// ConversionTable returns the table of the units in the Family in the
+// given format. See WriteConversionTable for details.
+func (f *Family) ConversionTable(tf TableFormat) (string, error) {
+	var sb strings.Builder
+
+	err := f.WriteConversionTable(&sb, tf)
+
+	return sb.String(), err
+}
@@ -0,0 +1,109 @@
+package units
+
+import (
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+// tableTestFamily is a small Family used to test the conversion tables
+var tableTestFamily = &Family{
+	baseUnitName: "metre",
+	description:  "unit of test distance",
+	name:         "table-test",
+	altUnits: map[string]Unit{
+		"metre": {
+			0, 0, 1, nil,
+			"m", "metre", "metres",
+			"", nil, nil, "", "",
+		},
+		"km": {
+			0, 0, 1000, nil,
+			"km", "kilometre", "kilometres",
+			"", nil, nil, "", "",
+		},
+		"bar_1": {
+			0, 2, 0.5, nil,
+			"b|1", "bar_1", "bar_1s",
+			"", nil, nil, "", "",
+		},
+	},
+}
+
+func init() {
+	for id, u := range tableTestFamily.altUnits {
+		u.f = tableTestFamily
+		tableTestFamily.altUnits[id] = u
+	}
+}
+
+func TestConversionTable(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		tf     TableFormat
+		expStr string
+	}{
+		{
+			ID: testhelper.MkID("Markdown"),
+			tf: TableMarkdown,
+			expStr: "| Unit | Symbol | Factor to metre | Conversion from metre |\n" +
+				"| --- | --- | ---: | --- |\n" +
+				`| bar\_1 | b\|1 | 0.5 | divide by 0.5 add 2 |` + "\n" +
+				"| metre | m | 1 | no conversion needed" +
+				" (already in the base units) |\n" +
+				"| km | km | 1000 | divide by 1000 |\n",
+		},
+		{
+			ID: testhelper.MkID("LaTeX"),
+			tf: TableLaTeX,
+			expStr: `\begin{tabular}{llrl}` + "\n" +
+				`\hline` + "\n" +
+				`Unit & Symbol & Factor to metre & Conversion from metre \\` +
+				"\n" +
+				`\hline` + "\n" +
+				`bar\_1 & \unit{b|1} & \num{0.5} & divide by 0.5 add 2 \\` +
+				"\n" +
+				`metre & \unit{m} & \num{1} & no conversion needed` +
+				` (already in the base units) \\` + "\n" +
+				`km & \unit{km} & \num{1000} & divide by 1000 \\` + "\n" +
+				`\hline` + "\n" +
+				`\end{tabular}` + "\n",
+		},
+		{
+			ID: testhelper.MkID("HTML"),
+			tf: TableHTML,
+			expStr: "<table>\n<thead>\n" +
+				"<tr><th>Unit</th><th>Symbol</th>" +
+				"<th>Factor to metre</th><th>Conversion from metre</th></tr>\n" +
+				"</thead>\n<tbody>\n" +
+				"<tr><td>bar_1</td><td>b|1</td><td>0.5</td>" +
+				"<td>divide by 0.5 add 2</td></tr>\n" +
+				"<tr><td>metre</td><td>m</td><td>1</td>" +
+				"<td>no conversion needed (already in the base units)</td>" +
+				"</tr>\n" +
+				"<tr><td>km</td><td>km</td><td>1000</td>" +
+				"<td>divide by 1000</td></tr>\n" +
+				"</tbody>\n</table>\n",
+		},
+		{
+			ID:     testhelper.MkID("bad format"),
+			ExpErr: testhelper.MkExpErr("there is no table format TableFormat(99)"),
+			tf:     TableFormat(99),
+		},
+	}
+
+	for _, tc := range testCases {
+		s, err := tableTestFamily.ConversionTable(tc.tf)
+		if testhelper.CheckExpErr(t, err, tc) && err == nil {
+			testhelper.DiffString(t, tc.IDStr(), "table", s, tc.expStr)
+		}
+	}
+}
+
+func TestTableFormatString(t *testing.T) {
+	testhelper.DiffString(t, "TableHTML", "string",
+		TableHTML.String(), "HTML")
+	testhelper.DiffString(t, "bad TableFormat", "string",
+		TableFormat(99).String(), "TableFormat(99)")
+}
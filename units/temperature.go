@@ -229,3 +229,40 @@ var temperatureNames = map[string]Unit{
 	"N":     degNUnit,
 	"D":     degDUnit,
 }
+
+// temperatureProvenance records the source of the conversion formula for
+// each unit of temperature
+var temperatureProvenance = map[string]Provenance{
+	bunTemp: provSI,
+	"F":     provNISTSP811,
+	"K":     provSI,
+	"Ra":    provNISTSP811,
+	"Ro":    provHistorical,
+	"Re":    provHistorical,
+	"N":     provHistorical,
+	"D":     provHistorical,
+}
+
+// temperatureUCUM records the UCUM codes of those units of temperature
+// which have one
+var temperatureUCUM = map[string]string{
+	"K":     "K",
+	bunTemp: "Cel",
+	"F":     "[degF]",
+	"Ra":    "[degR]",
+	"Re":    "[degRe]",
+}
+
+// temperatureExternalIDs records the identifiers of the units of
+// temperature in external schemes (see Unit.ExternalIDs)
+var temperatureExternalIDs = map[string]map[string]string{
+	bunTemp: {
+		SchemeQUDT: "DEG_C", SchemeWikidata: "Q25267",
+		SchemeOM: "degreeCelsius",
+	},
+	"K": {SchemeQUDT: "K", SchemeWikidata: "Q11579", SchemeOM: "kelvin"},
+	"F": {
+		SchemeQUDT: "DEG_F", SchemeWikidata: "Q42289",
+		SchemeOM: "degreeFahrenheit",
+	},
+}
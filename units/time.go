@@ -189,6 +189,35 @@ var timeNames = map[string]Unit{
 		},
 		"", "",
 	},
+	"month": {
+		0, 0, daysPerGregorianYear * dayToSec / 12,
+		timeFamily,
+		"month", "month", "months",
+		"one twelfth of a Gregorian year." +
+			" This is the average length of a calendar month;" +
+			" calendar months vary in length from 28 to 31 days." +
+			" See ValUnit.AddTo for exact calendar arithmetic.",
+		[]Tag{TagColloquial},
+		map[string]string{
+			"months":         "plural",
+			"calendar month": "alternative",
+		},
+		"", "",
+	},
+	"quarter": {
+		0, 0, daysPerGregorianYear * dayToSec / 4,
+		timeFamily,
+		"quarter", "quarter", "quarters",
+		"three months, one quarter of a Gregorian year." +
+			" This is the average length of a calendar quarter;" +
+			" see ValUnit.AddTo for exact calendar arithmetic.",
+		[]Tag{TagColloquial},
+		map[string]string{
+			"quarters":         "plural",
+			"calendar quarter": "alternative",
+		},
+		"", "",
+	},
 	"lunar month": {
 		0, 0, dayToSec * 28,
 		timeFamily,
@@ -328,6 +357,23 @@ var timeNames = map[string]Unit{
 		},
 		"", "",
 	},
+	"Julian month": {
+		0, 0, 365.25 * dayToSec / 12,
+		timeFamily,
+		"Julian month", "Julian month", "Julian months",
+		"one twelfth of a Julian year, 30.4375 days." +
+			" This is the mean month of the Unified Code for Units of" +
+			" Measure (UCUM).",
+		[]Tag{TagAstro},
+		map[string]string{
+			"Julian months": "plural",
+			"Julian-month":  "hyphenated",
+			"Julian-months": "hyphenated, plural",
+			"julian month":  "lowercase",
+			"julian months": "lowercase, plural",
+		},
+		"", "",
+	},
 	"Gregorian year": {
 		0, 0, daysPerGregorianYear * dayToSec,
 		timeFamily,
@@ -536,3 +582,169 @@ var timeNames = map[string]Unit{
 		"", "",
 	},
 }
+
+// timeProvenance records the source of the conversion factor for each unit
+// of time
+var timeProvenance = map[string]Provenance{
+	bunTime: provSI,
+	"ysec":  provSI,
+	"zsec":  provSI,
+	"asec":  provSI,
+	"fsec":  provSI,
+	"psec":  provSI,
+	"nsec":  provSI,
+	"usec":  provSI,
+	"msec":  provSI,
+	"csec":  provSI,
+	"dsec":  provSI,
+	"dasec": provSI,
+	"hsec":  provSI,
+	"ksec":  provSI,
+	"Msec":  provSI,
+	"Gsec":  provSI,
+	"Tsec":  provSI,
+	"Psec":  provSI,
+	"Esec":  provSI,
+	"Zsec":  provSI,
+	"Ysec":  provSI,
+
+	"minute":    provSI,
+	"hour":      provSI,
+	"day":       provSI,
+	"week":      {Kind: ConvExact, Source: SrcISO8601},
+	"fortnight": provCommonExact,
+
+	"month":       {Kind: ConvExact, Source: SrcGregorian},
+	"quarter":     {Kind: ConvExact, Source: SrcGregorian},
+	"lunar month": provColloquial,
+	"lunation": {
+		Kind: ConvMeasured, Source: SrcAstroAlmanac, SigDigits: 11,
+	},
+	"sidereal month": {
+		Kind: ConvMeasured, Source: SrcAstroAlmanac, SigDigits: 11,
+	},
+	"draconic month": {
+		Kind: ConvMeasured, Source: SrcAstroAlmanac, SigDigits: 11,
+	},
+
+	"Julian month":   {Kind: ConvExact, Source: SrcIAU2015},
+	"Julian year":    {Kind: ConvExact, Source: SrcIAU2015},
+	"Gregorian year": {Kind: ConvExact, Source: SrcGregorian},
+	"Sidereal year": {
+		Kind: ConvMeasured, Source: SrcAstroAlmanac, SigDigits: 12,
+	},
+	"Tropical year": {
+		Kind: ConvMeasured, Source: SrcAstroAlmanac, SigDigits: 8,
+	},
+	"century":    {Kind: ConvExact, Source: SrcGregorian},
+	"millennium": {Kind: ConvExact, Source: SrcGregorian},
+	"aeon":       provColloquial,
+}
+
+// timeCalendarMonths records the length, in calendar months, of those
+// units of time which are calendar-relative. The length of such a unit
+// depends on the date from which it is measured; see ValUnit.AddTo.
+var timeCalendarMonths = map[string]int{
+	"month":          1,
+	"quarter":        3,
+	"Gregorian year": 12,
+	"century":        1200,
+	"millennium":     12000,
+}
+
+// timeSymbols records the typographic symbols of those units of time whose
+// symbols differ from their abbreviations
+var timeSymbols = map[string]string{
+	"ysec":        "ys",
+	"zsec":        "zs",
+	"asec":        "as",
+	"fsec":        "fs",
+	"psec":        "ps",
+	"nsec":        "ns",
+	"usec":        "µs",
+	"msec":        "ms",
+	"csec":        "cs",
+	"dsec":        "ds",
+	bunTime:       "s",
+	"dasec":       "das",
+	"hsec":        "hs",
+	"ksec":        "ks",
+	"Msec":        "Ms",
+	"Gsec":        "Gs",
+	"Tsec":        "Ts",
+	"Psec":        "Ps",
+	"Esec":        "Es",
+	"Zsec":        "Zs",
+	"Ysec":        "Ys",
+	"hour":        "h",
+	"day":         "d",
+	"Julian year": "a",
+}
+
+// timeUCUM records the UCUM codes of those units of time which have one
+var timeUCUM = map[string]string{
+	"ysec":  "ys",
+	"zsec":  "zs",
+	"asec":  "as",
+	"fsec":  "fs",
+	"psec":  "ps",
+	"nsec":  "ns",
+	"usec":  "us",
+	"msec":  "ms",
+	"csec":  "cs",
+	"dsec":  "ds",
+	bunTime: "s",
+	"dasec": "das",
+	"hsec":  "hs",
+	"ksec":  "ks",
+	"Msec":  "Ms",
+	"Gsec":  "Gs",
+	"Tsec":  "Ts",
+	"Psec":  "Ps",
+	"Esec":  "Es",
+	"Zsec":  "Zs",
+	"Ysec":  "Ys",
+
+	"minute":         "min",
+	"hour":           "h",
+	"day":            "d",
+	"week":           "wk",
+	"month":          "mo_g",
+	"lunation":       "mo_s",
+	"Julian month":   "mo_j",
+	"Julian year":    "a_j",
+	"Gregorian year": "a_g",
+	"Tropical year":  "a_t",
+}
+
+// timeRec20 records the UN/ECE Recommendation 20 codes of those units of time
+// which have one
+var timeRec20 = map[string]string{
+	"psec":  "H70",
+	"nsec":  "C47",
+	"usec":  "B98",
+	"msec":  "C26",
+	bunTime: "SEC",
+
+	"minute":         "MIN",
+	"hour":           "HUR",
+	"day":            "DAY",
+	"week":           "WEE",
+	"month":          "MON",
+	"quarter":        "QAN",
+	"Gregorian year": "ANN",
+}
+
+// timeExternalIDs records the identifiers of the units of time in external
+// schemes (see Unit.ExternalIDs)
+var timeExternalIDs = map[string]map[string]string{
+	bunTime: {
+		SchemeQUDT: "SEC", SchemeWikidata: "Q11574", SchemeOM: "second-Time",
+	},
+	"minute": {
+		SchemeQUDT: "MIN", SchemeWikidata: "Q7727", SchemeOM: "minute-Time",
+	},
+	"hour": {SchemeQUDT: "HR", SchemeWikidata: "Q25235", SchemeOM: "hour"},
+	"day":  {SchemeQUDT: "DAY", SchemeWikidata: "Q573", SchemeOM: "day"},
+	"week": {SchemeQUDT: "WK", SchemeWikidata: "Q23387", SchemeOM: "week"},
+}
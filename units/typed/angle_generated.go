@@ -0,0 +1,114 @@
+// Code generated by gentyped; DO NOT EDIT.
+
+package typed
+
+import "github.com/nickwells/units.mod/v2/units"
+
+// Angle is a angle held in radians, the base units of the
+// angle Family.
+type Angle float64
+
+// angleBaseUnit is the base unit of the angle Family
+var angleBaseUnit = units.GetOrPanic("angle", "radian")
+
+// AngleFromValUnit returns the Angle having the value of the
+// ValUnit. It returns a non-nil error if the ValUnit is not a angle.
+func AngleFromValUnit(vu units.ValUnit) (Angle, error) {
+	base, err := vu.Convert(angleBaseUnit)
+
+	return Angle(base.V), err
+}
+
+// ValUnit returns the Angle as a ValUnit in radians
+func (a Angle) ValUnit() units.ValUnit {
+	return units.ValUnit{V: float64(a), U: angleBaseUnit}
+}
+
+// String returns the Angle formatted as a ValUnit in radians
+func (a Angle) String() string {
+	return a.ValUnit().String()
+}
+
+// AngleFromDegrees returns the Angle given a
+// value in units of "degree"
+func AngleFromDegrees(val float64) Angle {
+	return Angle(val * 0.017453292519943295)
+}
+
+// Degrees returns the Angle in units of
+// "degree"
+func (a Angle) Degrees() float64 {
+	base := float64(a)
+
+	return base / 0.017453292519943295
+}
+
+// AngleFromGradians returns the Angle given a
+// value in units of "gradian"
+func AngleFromGradians(val float64) Angle {
+	return Angle(val * 0.015707963267948967)
+}
+
+// Gradians returns the Angle in units of
+// "gradian"
+func (a Angle) Gradians() float64 {
+	base := float64(a)
+
+	return base / 0.015707963267948967
+}
+
+// AngleFromMilliradians returns the Angle given a
+// value in units of "milliradian"
+func AngleFromMilliradians(val float64) Angle {
+	return Angle(val * 0.001)
+}
+
+// Milliradians returns the Angle in units of
+// "milliradian"
+func (a Angle) Milliradians() float64 {
+	base := float64(a)
+
+	return base / 0.001
+}
+
+// AngleFromArcMinutes returns the Angle given a
+// value in units of "minute"
+func AngleFromArcMinutes(val float64) Angle {
+	return Angle(val * 0.0002908882086657216)
+}
+
+// ArcMinutes returns the Angle in units of
+// "minute"
+func (a Angle) ArcMinutes() float64 {
+	base := float64(a)
+
+	return base / 0.0002908882086657216
+}
+
+// AngleFromRadians returns the Angle given a
+// value in units of "radian"
+func AngleFromRadians(val float64) Angle {
+	return Angle(val)
+}
+
+// Radians returns the Angle in units of
+// "radian"
+func (a Angle) Radians() float64 {
+	base := float64(a)
+
+	return base
+}
+
+// AngleFromArcSeconds returns the Angle given a
+// value in units of "second"
+func AngleFromArcSeconds(val float64) Angle {
+	return Angle(val * 4.84813681109536e-06)
+}
+
+// ArcSeconds returns the Angle in units of
+// "second"
+func (a Angle) ArcSeconds() float64 {
+	base := float64(a)
+
+	return base / 4.84813681109536e-06
+}
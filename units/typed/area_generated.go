@@ -0,0 +1,268 @@
+// Code generated by gentyped; DO NOT EDIT.
+
+package typed
+
+import "github.com/nickwells/units.mod/v2/units"
+
+// Area is a area held in square metres, the base units of the
+// area Family.
+type Area float64
+
+// areaBaseUnit is the base unit of the area Family
+var areaBaseUnit = units.GetOrPanic("area", "square metre")
+
+// AreaFromValUnit returns the Area having the value of the
+// ValUnit. It returns a non-nil error if the ValUnit is not a area.
+func AreaFromValUnit(vu units.ValUnit) (Area, error) {
+	base, err := vu.Convert(areaBaseUnit)
+
+	return Area(base.V), err
+}
+
+// ValUnit returns the Area as a ValUnit in square metres
+func (a Area) ValUnit() units.ValUnit {
+	return units.ValUnit{V: float64(a), U: areaBaseUnit}
+}
+
+// String returns the Area formatted as a ValUnit in square metres
+func (a Area) String() string {
+	return a.ValUnit().String()
+}
+
+// AreaFromFootballPitchesUS returns the Area given a
+// value in units of "American football pitch"
+func AreaFromFootballPitchesUS(val float64) Area {
+	return Area(val * 5351.215104)
+}
+
+// FootballPitchesUS returns the Area in units of
+// "American football pitch"
+func (a Area) FootballPitchesUS() float64 {
+	base := float64(a)
+
+	return base / 5351.215104
+}
+
+// AreaFromTimesTheSizeOfWales returns the Area given a
+// value in units of "Wales"
+func AreaFromTimesTheSizeOfWales(val float64) Area {
+	return Area(val * 2.0779e+10)
+}
+
+// TimesTheSizeOfWales returns the Area in units of
+// "Wales"
+func (a Area) TimesTheSizeOfWales() float64 {
+	base := float64(a)
+
+	return base / 2.0779e+10
+}
+
+// AreaFromAcres returns the Area given a
+// value in units of "acre"
+func AreaFromAcres(val float64) Area {
+	return Area(val * 4046.8564224)
+}
+
+// Acres returns the Area in units of
+// "acre"
+func (a Area) Acres() float64 {
+	base := float64(a)
+
+	return base / 4046.8564224
+}
+
+// AreaFromAres returns the Area given a
+// value in units of "are"
+func AreaFromAres(val float64) Area {
+	return Area(val * 100.0)
+}
+
+// Ares returns the Area in units of
+// "are"
+func (a Area) Ares() float64 {
+	base := float64(a)
+
+	return base / 100.0
+}
+
+// AreaFromCarucates returns the Area given a
+// value in units of "carucate"
+func AreaFromCarucates(val float64) Area {
+	return Area(val * 485622.770688)
+}
+
+// Carucates returns the Area in units of
+// "carucate"
+func (a Area) Carucates() float64 {
+	base := float64(a)
+
+	return base / 485622.770688
+}
+
+// AreaFromDecares returns the Area given a
+// value in units of "decare"
+func AreaFromDecares(val float64) Area {
+	return Area(val * 1000.0)
+}
+
+// Decares returns the Area in units of
+// "decare"
+func (a Area) Decares() float64 {
+	base := float64(a)
+
+	return base / 1000.0
+}
+
+// AreaFromFootballPitches returns the Area given a
+// value in units of "football pitch"
+func AreaFromFootballPitches(val float64) Area {
+	return Area(val * 7140.0)
+}
+
+// FootballPitches returns the Area in units of
+// "football pitch"
+func (a Area) FootballPitches() float64 {
+	base := float64(a)
+
+	return base / 7140.0
+}
+
+// AreaFromHectares returns the Area given a
+// value in units of "hectare"
+func AreaFromHectares(val float64) Area {
+	return Area(val * 10000.0)
+}
+
+// Hectares returns the Area in units of
+// "hectare"
+func (a Area) Hectares() float64 {
+	base := float64(a)
+
+	return base / 10000.0
+}
+
+// AreaFromOxgangs returns the Area given a
+// value in units of "oxgang"
+func AreaFromOxgangs(val float64) Area {
+	return Area(val * 60702.846336)
+}
+
+// Oxgangs returns the Area in units of
+// "oxgang"
+func (a Area) Oxgangs() float64 {
+	base := float64(a)
+
+	return base / 60702.846336
+}
+
+// AreaFromSquarePerches returns the Area given a
+// value in units of "perch"
+func AreaFromSquarePerches(val float64) Area {
+	return Area(val * 25.29285264)
+}
+
+// SquarePerches returns the Area in units of
+// "perch"
+func (a Area) SquarePerches() float64 {
+	base := float64(a)
+
+	return base / 25.29285264
+}
+
+// AreaFromRoods returns the Area given a
+// value in units of "rood"
+func AreaFromRoods(val float64) Area {
+	return Area(val * 1011.7141056)
+}
+
+// Roods returns the Area in units of
+// "rood"
+func (a Area) Roods() float64 {
+	base := float64(a)
+
+	return base / 1011.7141056
+}
+
+// AreaFromSquareFeet returns the Area given a
+// value in units of "square foot"
+func AreaFromSquareFeet(val float64) Area {
+	return Area(val * 0.09290304)
+}
+
+// SquareFeet returns the Area in units of
+// "square foot"
+func (a Area) SquareFeet() float64 {
+	base := float64(a)
+
+	return base / 0.09290304
+}
+
+// AreaFromSquareKilometres returns the Area given a
+// value in units of "square kilometre"
+func AreaFromSquareKilometres(val float64) Area {
+	return Area(val * 1e+06)
+}
+
+// SquareKilometres returns the Area in units of
+// "square kilometre"
+func (a Area) SquareKilometres() float64 {
+	base := float64(a)
+
+	return base / 1e+06
+}
+
+// AreaFromSquareMetres returns the Area given a
+// value in units of "square metre"
+func AreaFromSquareMetres(val float64) Area {
+	return Area(val)
+}
+
+// SquareMetres returns the Area in units of
+// "square metre"
+func (a Area) SquareMetres() float64 {
+	base := float64(a)
+
+	return base
+}
+
+// AreaFromSquareMiles returns the Area given a
+// value in units of "square mile"
+func AreaFromSquareMiles(val float64) Area {
+	return Area(val * 2.589988110336e+06)
+}
+
+// SquareMiles returns the Area in units of
+// "square mile"
+func (a Area) SquareMiles() float64 {
+	base := float64(a)
+
+	return base / 2.589988110336e+06
+}
+
+// AreaFromSquareYards returns the Area given a
+// value in units of "square yard"
+func AreaFromSquareYards(val float64) Area {
+	return Area(val * 0.83612736)
+}
+
+// SquareYards returns the Area in units of
+// "square yard"
+func (a Area) SquareYards() float64 {
+	base := float64(a)
+
+	return base / 0.83612736
+}
+
+// AreaFromVirgates returns the Area given a
+// value in units of "virgate"
+func AreaFromVirgates(val float64) Area {
+	return Area(val * 121405.692672)
+}
+
+// Virgates returns the Area in units of
+// "virgate"
+func (a Area) Virgates() float64 {
+	base := float64(a)
+
+	return base / 121405.692672
+}
@@ -0,0 +1,73 @@
+// Code generated by gentyped; DO NOT EDIT.
+
+package typed
+
+// MulDistance returns the Area given by multiplying the Distance by other
+func (d Distance) MulDistance(other Distance) Area {
+	return Area(float64(d) * float64(other))
+}
+
+// MulArea returns the Volume given by multiplying the Distance by other
+func (d Distance) MulArea(other Area) Volume {
+	return Volume(float64(d) * float64(other))
+}
+
+// MulDistance returns the Volume given by multiplying the Area by other
+func (a Area) MulDistance(other Distance) Volume {
+	return Volume(float64(a) * float64(other))
+}
+
+// DivDistance returns the Distance given by dividing the Area by other
+func (a Area) DivDistance(other Distance) Distance {
+	return Distance(float64(a) / float64(other))
+}
+
+// DivDistance returns the Area given by dividing the Volume by other
+func (v Volume) DivDistance(other Distance) Area {
+	return Area(float64(v) / float64(other))
+}
+
+// DivArea returns the Distance given by dividing the Volume by other
+func (v Volume) DivArea(other Area) Distance {
+	return Distance(float64(v) / float64(other))
+}
+
+// DivTime returns the Velocity given by dividing the Distance by other
+func (d Distance) DivTime(other Time) Velocity {
+	return Velocity(float64(d) / float64(other))
+}
+
+// DivVelocity returns the Time given by dividing the Distance by other
+func (d Distance) DivVelocity(other Velocity) Time {
+	return Time(float64(d) / float64(other))
+}
+
+// MulTime returns the Distance given by multiplying the Velocity by other
+func (v Velocity) MulTime(other Time) Distance {
+	return Distance(float64(v) * float64(other))
+}
+
+// MulVelocity returns the Distance given by multiplying the Time by other
+func (t Time) MulVelocity(other Velocity) Distance {
+	return Distance(float64(t) * float64(other))
+}
+
+// MulVolume returns the Energy given by multiplying the Pressure by other
+func (p Pressure) MulVolume(other Volume) Energy {
+	return Energy(float64(p) * float64(other))
+}
+
+// MulPressure returns the Energy given by multiplying the Volume by other
+func (v Volume) MulPressure(other Pressure) Energy {
+	return Energy(float64(v) * float64(other))
+}
+
+// DivVolume returns the Pressure given by dividing the Energy by other
+func (e Energy) DivVolume(other Volume) Pressure {
+	return Pressure(float64(e) / float64(other))
+}
+
+// DivPressure returns the Volume given by dividing the Energy by other
+func (e Energy) DivPressure(other Pressure) Volume {
+	return Volume(float64(e) / float64(other))
+}
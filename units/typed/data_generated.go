@@ -0,0 +1,296 @@
+// Code generated by gentyped; DO NOT EDIT.
+
+package typed
+
+import "github.com/nickwells/units.mod/v2/units"
+
+// Data is a data held in bytes, the base units of the
+// data Family.
+type Data float64
+
+// dataBaseUnit is the base unit of the data Family
+var dataBaseUnit = units.GetOrPanic("data", "byte")
+
+// DataFromValUnit returns the Data having the value of the
+// ValUnit. It returns a non-nil error if the ValUnit is not a data.
+func DataFromValUnit(vu units.ValUnit) (Data, error) {
+	base, err := vu.Convert(dataBaseUnit)
+
+	return Data(base.V), err
+}
+
+// ValUnit returns the Data as a ValUnit in bytes
+func (d Data) ValUnit() units.ValUnit {
+	return units.ValUnit{V: float64(d), U: dataBaseUnit}
+}
+
+// String returns the Data formatted as a ValUnit in bytes
+func (d Data) String() string {
+	return d.ValUnit().String()
+}
+
+// DataFromExabytes returns the Data given a
+// value in units of "EB"
+func DataFromExabytes(val float64) Data {
+	return Data(val * 1e+18)
+}
+
+// Exabytes returns the Data in units of
+// "EB"
+func (d Data) Exabytes() float64 {
+	base := float64(d)
+
+	return base / 1e+18
+}
+
+// DataFromExbibytes returns the Data given a
+// value in units of "EiB"
+func DataFromExbibytes(val float64) Data {
+	return Data(val * 1.152921504606847e+18)
+}
+
+// Exbibytes returns the Data in units of
+// "EiB"
+func (d Data) Exbibytes() float64 {
+	base := float64(d)
+
+	return base / 1.152921504606847e+18
+}
+
+// DataFromGigabytes returns the Data given a
+// value in units of "GB"
+func DataFromGigabytes(val float64) Data {
+	return Data(val * 1e+09)
+}
+
+// Gigabytes returns the Data in units of
+// "GB"
+func (d Data) Gigabytes() float64 {
+	base := float64(d)
+
+	return base / 1e+09
+}
+
+// DataFromGibibytes returns the Data given a
+// value in units of "GiB"
+func DataFromGibibytes(val float64) Data {
+	return Data(val * 1.073741824e+09)
+}
+
+// Gibibytes returns the Data in units of
+// "GiB"
+func (d Data) Gibibytes() float64 {
+	base := float64(d)
+
+	return base / 1.073741824e+09
+}
+
+// DataFromKilobytes returns the Data given a
+// value in units of "KB"
+func DataFromKilobytes(val float64) Data {
+	return Data(val * 1000.0)
+}
+
+// Kilobytes returns the Data in units of
+// "KB"
+func (d Data) Kilobytes() float64 {
+	base := float64(d)
+
+	return base / 1000.0
+}
+
+// DataFromKibibytes returns the Data given a
+// value in units of "KiB"
+func DataFromKibibytes(val float64) Data {
+	return Data(val * 1024.0)
+}
+
+// Kibibytes returns the Data in units of
+// "KiB"
+func (d Data) Kibibytes() float64 {
+	base := float64(d)
+
+	return base / 1024.0
+}
+
+// DataFromMegabytes returns the Data given a
+// value in units of "MB"
+func DataFromMegabytes(val float64) Data {
+	return Data(val * 1e+06)
+}
+
+// Megabytes returns the Data in units of
+// "MB"
+func (d Data) Megabytes() float64 {
+	base := float64(d)
+
+	return base / 1e+06
+}
+
+// DataFromMebibytes returns the Data given a
+// value in units of "MiB"
+func DataFromMebibytes(val float64) Data {
+	return Data(val * 1.048576e+06)
+}
+
+// Mebibytes returns the Data in units of
+// "MiB"
+func (d Data) Mebibytes() float64 {
+	base := float64(d)
+
+	return base / 1.048576e+06
+}
+
+// DataFromPetabytes returns the Data given a
+// value in units of "PB"
+func DataFromPetabytes(val float64) Data {
+	return Data(val * 1e+15)
+}
+
+// Petabytes returns the Data in units of
+// "PB"
+func (d Data) Petabytes() float64 {
+	base := float64(d)
+
+	return base / 1e+15
+}
+
+// DataFromPebibytes returns the Data given a
+// value in units of "PiB"
+func DataFromPebibytes(val float64) Data {
+	return Data(val * 1.125899906842624e+15)
+}
+
+// Pebibytes returns the Data in units of
+// "PiB"
+func (d Data) Pebibytes() float64 {
+	base := float64(d)
+
+	return base / 1.125899906842624e+15
+}
+
+// DataFromTerabytes returns the Data given a
+// value in units of "TB"
+func DataFromTerabytes(val float64) Data {
+	return Data(val * 1e+12)
+}
+
+// Terabytes returns the Data in units of
+// "TB"
+func (d Data) Terabytes() float64 {
+	base := float64(d)
+
+	return base / 1e+12
+}
+
+// DataFromTebibytes returns the Data given a
+// value in units of "TiB"
+func DataFromTebibytes(val float64) Data {
+	return Data(val * 1.099511627776e+12)
+}
+
+// Tebibytes returns the Data in units of
+// "TiB"
+func (d Data) Tebibytes() float64 {
+	base := float64(d)
+
+	return base / 1.099511627776e+12
+}
+
+// DataFromYottabytes returns the Data given a
+// value in units of "YB"
+func DataFromYottabytes(val float64) Data {
+	return Data(val * 1e+24)
+}
+
+// Yottabytes returns the Data in units of
+// "YB"
+func (d Data) Yottabytes() float64 {
+	base := float64(d)
+
+	return base / 1e+24
+}
+
+// DataFromYobibytes returns the Data given a
+// value in units of "YiB"
+func DataFromYobibytes(val float64) Data {
+	return Data(val * 1.2089258196146292e+24)
+}
+
+// Yobibytes returns the Data in units of
+// "YiB"
+func (d Data) Yobibytes() float64 {
+	base := float64(d)
+
+	return base / 1.2089258196146292e+24
+}
+
+// DataFromZettabytes returns the Data given a
+// value in units of "ZB"
+func DataFromZettabytes(val float64) Data {
+	return Data(val * 1e+21)
+}
+
+// Zettabytes returns the Data in units of
+// "ZB"
+func (d Data) Zettabytes() float64 {
+	base := float64(d)
+
+	return base / 1e+21
+}
+
+// DataFromZebibytes returns the Data given a
+// value in units of "ZiB"
+func DataFromZebibytes(val float64) Data {
+	return Data(val * 1.1805916207174113e+21)
+}
+
+// Zebibytes returns the Data in units of
+// "ZiB"
+func (d Data) Zebibytes() float64 {
+	base := float64(d)
+
+	return base / 1.1805916207174113e+21
+}
+
+// DataFromBits returns the Data given a
+// value in units of "bit"
+func DataFromBits(val float64) Data {
+	return Data(val * 0.125)
+}
+
+// Bits returns the Data in units of
+// "bit"
+func (d Data) Bits() float64 {
+	base := float64(d)
+
+	return base / 0.125
+}
+
+// DataFromBytes returns the Data given a
+// value in units of "byte"
+func DataFromBytes(val float64) Data {
+	return Data(val)
+}
+
+// Bytes returns the Data in units of
+// "byte"
+func (d Data) Bytes() float64 {
+	base := float64(d)
+
+	return base
+}
+
+// DataFromNibbles returns the Data given a
+// value in units of "nibble"
+func DataFromNibbles(val float64) Data {
+	return Data(val * 0.5)
+}
+
+// Nibbles returns the Data in units of
+// "nibble"
+func (d Data) Nibbles() float64 {
+	base := float64(d)
+
+	return base / 0.5
+}
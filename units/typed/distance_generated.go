@@ -0,0 +1,982 @@
+// Code generated by gentyped; DO NOT EDIT.
+
+package typed
+
+import "github.com/nickwells/units.mod/v2/units"
+
+// Distance is a distance held in metres, the base units of the
+// distance Family.
+type Distance float64
+
+// distanceBaseUnit is the base unit of the distance Family
+var distanceBaseUnit = units.GetOrPanic("distance", "metre")
+
+// DistanceFromValUnit returns the Distance having the value of the
+// ValUnit. It returns a non-nil error if the ValUnit is not a distance.
+func DistanceFromValUnit(vu units.ValUnit) (Distance, error) {
+	base, err := vu.Convert(distanceBaseUnit)
+
+	return Distance(base.V), err
+}
+
+// ValUnit returns the Distance as a ValUnit in metres
+func (d Distance) ValUnit() units.ValUnit {
+	return units.ValUnit{V: float64(d), U: distanceBaseUnit}
+}
+
+// String returns the Distance formatted as a ValUnit in metres
+func (d Distance) String() string {
+	return d.ValUnit().String()
+}
+
+// DistanceFromLengthsOfA20ftShippingContainer returns the Distance given a
+// value in units of "20ft shipping container"
+func DistanceFromLengthsOfA20ftShippingContainer(val float64) Distance {
+	return Distance(val * 6.0579)
+}
+
+// LengthsOfA20ftShippingContainer returns the Distance in units of
+// "20ft shipping container"
+func (d Distance) LengthsOfA20ftShippingContainer() float64 {
+	base := float64(d)
+
+	return base / 6.0579
+}
+
+// DistanceFromEiffelTowers returns the Distance given a
+// value in units of "Eiffel Tower"
+func DistanceFromEiffelTowers(val float64) Distance {
+	return Distance(val * 300.0)
+}
+
+// EiffelTowers returns the Distance in units of
+// "Eiffel Tower"
+func (d Distance) EiffelTowers() float64 {
+	base := float64(d)
+
+	return base / 300.0
+}
+
+// DistanceFromExametres returns the Distance given a
+// value in units of "Em"
+func DistanceFromExametres(val float64) Distance {
+	return Distance(val * 1e+18)
+}
+
+// Exametres returns the Distance in units of
+// "Em"
+func (d Distance) Exametres() float64 {
+	base := float64(d)
+
+	return base / 1e+18
+}
+
+// DistanceFromGigametres returns the Distance given a
+// value in units of "Gm"
+func DistanceFromGigametres(val float64) Distance {
+	return Distance(val * 1e+09)
+}
+
+// Gigametres returns the Distance in units of
+// "Gm"
+func (d Distance) Gigametres() float64 {
+	base := float64(d)
+
+	return base / 1e+09
+}
+
+// DistanceFromIndianSurveyFeet returns the Distance given a
+// value in units of "Indian survey foot"
+func DistanceFromIndianSurveyFeet(val float64) Distance {
+	return Distance(val * 0.3047995)
+}
+
+// IndianSurveyFeet returns the Distance in units of
+// "Indian survey foot"
+func (d Distance) IndianSurveyFeet() float64 {
+	base := float64(d)
+
+	return base / 0.3047995
+}
+
+// DistanceFromMegametres returns the Distance given a
+// value in units of "Mm"
+func DistanceFromMegametres(val float64) Distance {
+	return Distance(val * 1e+06)
+}
+
+// Megametres returns the Distance in units of
+// "Mm"
+func (d Distance) Megametres() float64 {
+	base := float64(d)
+
+	return base / 1e+06
+}
+
+// DistanceFromPetametres returns the Distance given a
+// value in units of "Pm"
+func DistanceFromPetametres(val float64) Distance {
+	return Distance(val * 1e+15)
+}
+
+// Petametres returns the Distance in units of
+// "Pm"
+func (d Distance) Petametres() float64 {
+	base := float64(d)
+
+	return base / 1e+15
+}
+
+// DistanceFromTerametres returns the Distance given a
+// value in units of "Tm"
+func DistanceFromTerametres(val float64) Distance {
+	return Distance(val * 1e+12)
+}
+
+// Terametres returns the Distance in units of
+// "Tm"
+func (d Distance) Terametres() float64 {
+	base := float64(d)
+
+	return base / 1e+12
+}
+
+// DistanceFromUSSurveyFeet returns the Distance given a
+// value in units of "US survey foot"
+func DistanceFromUSSurveyFeet(val float64) Distance {
+	return Distance(val * 0.3048006096012192)
+}
+
+// USSurveyFeet returns the Distance in units of
+// "US survey foot"
+func (d Distance) USSurveyFeet() float64 {
+	base := float64(d)
+
+	return base / 0.3048006096012192
+}
+
+// DistanceFromYottametres returns the Distance given a
+// value in units of "Ym"
+func DistanceFromYottametres(val float64) Distance {
+	return Distance(val * 1e+24)
+}
+
+// Yottametres returns the Distance in units of
+// "Ym"
+func (d Distance) Yottametres() float64 {
+	base := float64(d)
+
+	return base / 1e+24
+}
+
+// DistanceFromZettametres returns the Distance given a
+// value in units of "Zm"
+func DistanceFromZettametres(val float64) Distance {
+	return Distance(val * 1e+21)
+}
+
+// Zettametres returns the Distance in units of
+// "Zm"
+func (d Distance) Zettametres() float64 {
+	base := float64(d)
+
+	return base / 1e+21
+}
+
+// DistanceFromAdmiraltyFathoms returns the Distance given a
+// value in units of "admiralty-fathom"
+func DistanceFromAdmiraltyFathoms(val float64) Distance {
+	return Distance(val * 1.853184)
+}
+
+// AdmiraltyFathoms returns the Distance in units of
+// "admiralty-fathom"
+func (d Distance) AdmiraltyFathoms() float64 {
+	base := float64(d)
+
+	return base / 1.853184
+}
+
+// DistanceFromAttometres returns the Distance given a
+// value in units of "am"
+func DistanceFromAttometres(val float64) Distance {
+	return Distance(val * 1e-18)
+}
+
+// Attometres returns the Distance in units of
+// "am"
+func (d Distance) Attometres() float64 {
+	base := float64(d)
+
+	return base / 1e-18
+}
+
+// DistanceFromAstronomicalUnits returns the Distance given a
+// value in units of "astro-unit"
+func DistanceFromAstronomicalUnits(val float64) Distance {
+	return Distance(val * 1.495978707e+11)
+}
+
+// AstronomicalUnits returns the Distance in units of
+// "astro-unit"
+func (d Distance) AstronomicalUnits() float64 {
+	base := float64(d)
+
+	return base / 1.495978707e+11
+}
+
+// DistanceFromBarleycorns returns the Distance given a
+// value in units of "barleycorn"
+func DistanceFromBarleycorns(val float64) Distance {
+	return Distance(val * 0.008466666666666667)
+}
+
+// Barleycorns returns the Distance in units of
+// "barleycorn"
+func (d Distance) Barleycorns() float64 {
+	base := float64(d)
+
+	return base / 0.008466666666666667
+}
+
+// DistanceFromLengthsOfABus returns the Distance given a
+// value in units of "bus"
+func DistanceFromLengthsOfABus(val float64) Distance {
+	return Distance(val * 8.38)
+}
+
+// LengthsOfABus returns the Distance in units of
+// "bus"
+func (d Distance) LengthsOfABus() float64 {
+	base := float64(d)
+
+	return base / 8.38
+}
+
+// DistanceFromCables returns the Distance given a
+// value in units of "cable"
+func DistanceFromCables(val float64) Distance {
+	return Distance(val * 185.0)
+}
+
+// Cables returns the Distance in units of
+// "cable"
+func (d Distance) Cables() float64 {
+	base := float64(d)
+
+	return base / 185.0
+}
+
+// DistanceFromChains returns the Distance given a
+// value in units of "chain"
+func DistanceFromChains(val float64) Distance {
+	return Distance(val * 20.1168)
+}
+
+// Chains returns the Distance in units of
+// "chain"
+func (d Distance) Chains() float64 {
+	base := float64(d)
+
+	return base / 20.1168
+}
+
+// DistanceFromCentimetres returns the Distance given a
+// value in units of "cm"
+func DistanceFromCentimetres(val float64) Distance {
+	return Distance(val * 0.01)
+}
+
+// Centimetres returns the Distance in units of
+// "cm"
+func (d Distance) Centimetres() float64 {
+	base := float64(d)
+
+	return base / 0.01
+}
+
+// DistanceFromDecametres returns the Distance given a
+// value in units of "dam"
+func DistanceFromDecametres(val float64) Distance {
+	return Distance(val * 10.0)
+}
+
+// Decametres returns the Distance in units of
+// "dam"
+func (d Distance) Decametres() float64 {
+	base := float64(d)
+
+	return base / 10.0
+}
+
+// DistanceFromDecimetres returns the Distance given a
+// value in units of "dm"
+func DistanceFromDecimetres(val float64) Distance {
+	return Distance(val * 0.1)
+}
+
+// Decimetres returns the Distance in units of
+// "dm"
+func (d Distance) Decimetres() float64 {
+	base := float64(d)
+
+	return base / 0.1
+}
+
+// DistanceFromElls returns the Distance given a
+// value in units of "ell"
+func DistanceFromElls(val float64) Distance {
+	return Distance(val * 1.143)
+}
+
+// Ells returns the Distance in units of
+// "ell"
+func (d Distance) Ells() float64 {
+	base := float64(d)
+
+	return base / 1.143
+}
+
+// DistanceFromFathoms returns the Distance given a
+// value in units of "fathom"
+func DistanceFromFathoms(val float64) Distance {
+	return Distance(val * 1.8288)
+}
+
+// Fathoms returns the Distance in units of
+// "fathom"
+func (d Distance) Fathoms() float64 {
+	base := float64(d)
+
+	return base / 1.8288
+}
+
+// DistanceFromFemtometres returns the Distance given a
+// value in units of "fm"
+func DistanceFromFemtometres(val float64) Distance {
+	return Distance(val * 1e-15)
+}
+
+// Femtometres returns the Distance in units of
+// "fm"
+func (d Distance) Femtometres() float64 {
+	base := float64(d)
+
+	return base / 1e-15
+}
+
+// DistanceFromFeet returns the Distance given a
+// value in units of "foot"
+func DistanceFromFeet(val float64) Distance {
+	return Distance(val * 0.3048)
+}
+
+// Feet returns the Distance in units of
+// "foot"
+func (d Distance) Feet() float64 {
+	base := float64(d)
+
+	return base / 0.3048
+}
+
+// DistanceFromAmsterdamseVoeten returns the Distance given a
+// value in units of "foot (Amsterdam)"
+func DistanceFromAmsterdamseVoeten(val float64) Distance {
+	return Distance(val * 0.283133)
+}
+
+// AmsterdamseVoeten returns the Distance in units of
+// "foot (Amsterdam)"
+func (d Distance) AmsterdamseVoeten() float64 {
+	base := float64(d)
+
+	return base / 0.283133
+}
+
+// DistanceFromFeetNorthGerman returns the Distance given a
+// value in units of "foot (North German)"
+func DistanceFromFeetNorthGerman(val float64) Distance {
+	return Distance(val * 0.33528)
+}
+
+// FeetNorthGerman returns the Distance in units of
+// "foot (North German)"
+func (d Distance) FeetNorthGerman() float64 {
+	base := float64(d)
+
+	return base / 0.33528
+}
+
+// DistanceFromFeetParisian returns the Distance given a
+// value in units of "foot (Parisian)"
+func DistanceFromFeetParisian(val float64) Distance {
+	return Distance(val * 0.325)
+}
+
+// FeetParisian returns the Distance in units of
+// "foot (Parisian)"
+func (d Distance) FeetParisian() float64 {
+	base := float64(d)
+
+	return base / 0.325
+}
+
+// DistanceFromRijnlandseVoeten returns the Distance given a
+// value in units of "foot (Rijnland)"
+func DistanceFromRijnlandseVoeten(val float64) Distance {
+	return Distance(val * 0.314)
+}
+
+// RijnlandseVoeten returns the Distance in units of
+// "foot (Rijnland)"
+func (d Distance) RijnlandseVoeten() float64 {
+	base := float64(d)
+
+	return base / 0.314
+}
+
+// DistanceFromFeetRoman returns the Distance given a
+// value in units of "foot (Roman)"
+func DistanceFromFeetRoman(val float64) Distance {
+	return Distance(val * 0.296)
+}
+
+// FeetRoman returns the Distance in units of
+// "foot (Roman)"
+func (d Distance) FeetRoman() float64 {
+	base := float64(d)
+
+	return base / 0.296
+}
+
+// DistanceFromFeetMetric returns the Distance given a
+// value in units of "foot (metric)"
+func DistanceFromFeetMetric(val float64) Distance {
+	return Distance(val * 0.3)
+}
+
+// FeetMetric returns the Distance in units of
+// "foot (metric)"
+func (d Distance) FeetMetric() float64 {
+	base := float64(d)
+
+	return base / 0.3
+}
+
+// DistanceFromFurlongs returns the Distance given a
+// value in units of "furlong"
+func DistanceFromFurlongs(val float64) Distance {
+	return Distance(val * 201.168)
+}
+
+// Furlongs returns the Distance in units of
+// "furlong"
+func (d Distance) Furlongs() float64 {
+	base := float64(d)
+
+	return base / 201.168
+}
+
+// DistanceFromGigaparsecs returns the Distance given a
+// value in units of "gigaparsec"
+func DistanceFromGigaparsecs(val float64) Distance {
+	return Distance(val * 3.0856775814913673e+25)
+}
+
+// Gigaparsecs returns the Distance in units of
+// "gigaparsec"
+func (d Distance) Gigaparsecs() float64 {
+	base := float64(d)
+
+	return base / 3.0856775814913673e+25
+}
+
+// DistanceFromHands returns the Distance given a
+// value in units of "hand"
+func DistanceFromHands(val float64) Distance {
+	return Distance(val * 0.1016)
+}
+
+// Hands returns the Distance in units of
+// "hand"
+func (d Distance) Hands() float64 {
+	base := float64(d)
+
+	return base / 0.1016
+}
+
+// DistanceFromHectometres returns the Distance given a
+// value in units of "hm"
+func DistanceFromHectometres(val float64) Distance {
+	return Distance(val * 100.0)
+}
+
+// Hectometres returns the Distance in units of
+// "hm"
+func (d Distance) Hectometres() float64 {
+	base := float64(d)
+
+	return base / 100.0
+}
+
+// DistanceFromInches returns the Distance given a
+// value in units of "inch"
+func DistanceFromInches(val float64) Distance {
+	return Distance(val * 0.0254)
+}
+
+// Inches returns the Distance in units of
+// "inch"
+func (d Distance) Inches() float64 {
+	base := float64(d)
+
+	return base / 0.0254
+}
+
+// DistanceFromIrishMiles returns the Distance given a
+// value in units of "irish mile"
+func DistanceFromIrishMiles(val float64) Distance {
+	return Distance(val * 2048.256)
+}
+
+// IrishMiles returns the Distance in units of
+// "irish mile"
+func (d Distance) IrishMiles() float64 {
+	base := float64(d)
+
+	return base / 2048.256
+}
+
+// DistanceFromKiloparsecs returns the Distance given a
+// value in units of "kiloparsec"
+func DistanceFromKiloparsecs(val float64) Distance {
+	return Distance(val * 3.085677581491367e+19)
+}
+
+// Kiloparsecs returns the Distance in units of
+// "kiloparsec"
+func (d Distance) Kiloparsecs() float64 {
+	base := float64(d)
+
+	return base / 3.085677581491367e+19
+}
+
+// DistanceFromKilometres returns the Distance given a
+// value in units of "km"
+func DistanceFromKilometres(val float64) Distance {
+	return Distance(val * 1000.0)
+}
+
+// Kilometres returns the Distance in units of
+// "km"
+func (d Distance) Kilometres() float64 {
+	base := float64(d)
+
+	return base / 1000.0
+}
+
+// DistanceFromLeagues returns the Distance given a
+// value in units of "league"
+func DistanceFromLeagues(val float64) Distance {
+	return Distance(val * 4828.032)
+}
+
+// Leagues returns the Distance in units of
+// "league"
+func (d Distance) Leagues() float64 {
+	base := float64(d)
+
+	return base / 4828.032
+}
+
+// DistanceFromLightSeconds returns the Distance given a
+// value in units of "light-second"
+func DistanceFromLightSeconds(val float64) Distance {
+	return Distance(val * 2.99792458e+08)
+}
+
+// LightSeconds returns the Distance in units of
+// "light-second"
+func (d Distance) LightSeconds() float64 {
+	base := float64(d)
+
+	return base / 2.99792458e+08
+}
+
+// DistanceFromLightYears returns the Distance given a
+// value in units of "light-year"
+func DistanceFromLightYears(val float64) Distance {
+	return Distance(val * 9.4607304725808e+15)
+}
+
+// LightYears returns the Distance in units of
+// "light-year"
+func (d Distance) LightYears() float64 {
+	base := float64(d)
+
+	return base / 9.4607304725808e+15
+}
+
+// DistanceFromLinks returns the Distance given a
+// value in units of "link"
+func DistanceFromLinks(val float64) Distance {
+	return Distance(val * 0.201168)
+}
+
+// Links returns the Distance in units of
+// "link"
+func (d Distance) Links() float64 {
+	base := float64(d)
+
+	return base / 0.201168
+}
+
+// DistanceFromMarathons returns the Distance given a
+// value in units of "marathon"
+func DistanceFromMarathons(val float64) Distance {
+	return Distance(val * 42195.0)
+}
+
+// Marathons returns the Distance in units of
+// "marathon"
+func (d Distance) Marathons() float64 {
+	base := float64(d)
+
+	return base / 42195.0
+}
+
+// DistanceFromMegaparsecs returns the Distance given a
+// value in units of "megaparsec"
+func DistanceFromMegaparsecs(val float64) Distance {
+	return Distance(val * 3.085677581491367e+22)
+}
+
+// Megaparsecs returns the Distance in units of
+// "megaparsec"
+func (d Distance) Megaparsecs() float64 {
+	base := float64(d)
+
+	return base / 3.085677581491367e+22
+}
+
+// DistanceFromMetres returns the Distance given a
+// value in units of "metre"
+func DistanceFromMetres(val float64) Distance {
+	return Distance(val)
+}
+
+// Metres returns the Distance in units of
+// "metre"
+func (d Distance) Metres() float64 {
+	base := float64(d)
+
+	return base
+}
+
+// DistanceFromMetricMiles returns the Distance given a
+// value in units of "metric-mile"
+func DistanceFromMetricMiles(val float64) Distance {
+	return Distance(val * 1500.0)
+}
+
+// MetricMiles returns the Distance in units of
+// "metric-mile"
+func (d Distance) MetricMiles() float64 {
+	base := float64(d)
+
+	return base / 1500.0
+}
+
+// DistanceFromMiles returns the Distance given a
+// value in units of "mile"
+func DistanceFromMiles(val float64) Distance {
+	return Distance(val * 1609.344)
+}
+
+// Miles returns the Distance in units of
+// "mile"
+func (d Distance) Miles() float64 {
+	base := float64(d)
+
+	return base / 1609.344
+}
+
+// DistanceFromMillimetres returns the Distance given a
+// value in units of "mm"
+func DistanceFromMillimetres(val float64) Distance {
+	return Distance(val * 0.001)
+}
+
+// Millimetres returns the Distance in units of
+// "mm"
+func (d Distance) Millimetres() float64 {
+	base := float64(d)
+
+	return base / 0.001
+}
+
+// DistanceFromMyriametres returns the Distance given a
+// value in units of "mym"
+func DistanceFromMyriametres(val float64) Distance {
+	return Distance(val * 10000.0)
+}
+
+// Myriametres returns the Distance in units of
+// "mym"
+func (d Distance) Myriametres() float64 {
+	base := float64(d)
+
+	return base / 10000.0
+}
+
+// DistanceFromNauticalLeagues returns the Distance given a
+// value in units of "nautical league"
+func DistanceFromNauticalLeagues(val float64) Distance {
+	return Distance(val * 5556.0)
+}
+
+// NauticalLeagues returns the Distance in units of
+// "nautical league"
+func (d Distance) NauticalLeagues() float64 {
+	base := float64(d)
+
+	return base / 5556.0
+}
+
+// DistanceFromNauticalMiles returns the Distance given a
+// value in units of "nautical-mile"
+func DistanceFromNauticalMiles(val float64) Distance {
+	return Distance(val * 1852.0)
+}
+
+// NauticalMiles returns the Distance in units of
+// "nautical-mile"
+func (d Distance) NauticalMiles() float64 {
+	base := float64(d)
+
+	return base / 1852.0
+}
+
+// DistanceFromAdmiraltyNauticalMiles returns the Distance given a
+// value in units of "nautical-mile (Admiralty/UK)"
+func DistanceFromAdmiraltyNauticalMiles(val float64) Distance {
+	return Distance(val * 1853.184)
+}
+
+// AdmiraltyNauticalMiles returns the Distance in units of
+// "nautical-mile (Admiralty/UK)"
+func (d Distance) AdmiraltyNauticalMiles() float64 {
+	base := float64(d)
+
+	return base / 1853.184
+}
+
+// DistanceFromNauticalMilesUS returns the Distance given a
+// value in units of "nautical-mile (US)"
+func DistanceFromNauticalMilesUS(val float64) Distance {
+	return Distance(val * 1853.24496)
+}
+
+// NauticalMilesUS returns the Distance in units of
+// "nautical-mile (US)"
+func (d Distance) NauticalMilesUS() float64 {
+	base := float64(d)
+
+	return base / 1853.24496
+}
+
+// DistanceFromNanometres returns the Distance given a
+// value in units of "nm"
+func DistanceFromNanometres(val float64) Distance {
+	return Distance(val * 1e-09)
+}
+
+// Nanometres returns the Distance in units of
+// "nm"
+func (d Distance) Nanometres() float64 {
+	base := float64(d)
+
+	return base / 1e-09
+}
+
+// DistanceFromParsecs returns the Distance given a
+// value in units of "parsec"
+func DistanceFromParsecs(val float64) Distance {
+	return Distance(val * 3.085677581491367e+16)
+}
+
+// Parsecs returns the Distance in units of
+// "parsec"
+func (d Distance) Parsecs() float64 {
+	base := float64(d)
+
+	return base / 3.085677581491367e+16
+}
+
+// DistanceFromPheet returns the Distance given a
+// value in units of "phoot"
+func DistanceFromPheet(val float64) Distance {
+	return Distance(val * 0.299792458)
+}
+
+// Pheet returns the Distance in units of
+// "phoot"
+func (d Distance) Pheet() float64 {
+	base := float64(d)
+
+	return base / 0.299792458
+}
+
+// DistanceFromPica returns the Distance given a
+// value in units of "pica"
+func DistanceFromPica(val float64) Distance {
+	return Distance(val * 0.0042336)
+}
+
+// Pica returns the Distance in units of
+// "pica"
+func (d Distance) Pica() float64 {
+	base := float64(d)
+
+	return base / 0.0042336
+}
+
+// DistanceFromPicometres returns the Distance given a
+// value in units of "pm"
+func DistanceFromPicometres(val float64) Distance {
+	return Distance(val * 1e-12)
+}
+
+// Picometres returns the Distance in units of
+// "pm"
+func (d Distance) Picometres() float64 {
+	base := float64(d)
+
+	return base / 1e-12
+}
+
+// DistanceFromPoints returns the Distance given a
+// value in units of "point"
+func DistanceFromPoints(val float64) Distance {
+	return Distance(val * 0.0003528)
+}
+
+// Points returns the Distance in units of
+// "point"
+func (d Distance) Points() float64 {
+	base := float64(d)
+
+	return base / 0.0003528
+}
+
+// DistanceFromRods returns the Distance given a
+// value in units of "rod"
+func DistanceFromRods(val float64) Distance {
+	return Distance(val * 5.0292)
+}
+
+// Rods returns the Distance in units of
+// "rod"
+func (d Distance) Rods() float64 {
+	base := float64(d)
+
+	return base / 5.0292
+}
+
+// DistanceFromScotsMiles returns the Distance given a
+// value in units of "scots mile"
+func DistanceFromScotsMiles(val float64) Distance {
+	return Distance(val * 1806.8544)
+}
+
+// ScotsMiles returns the Distance in units of
+// "scots mile"
+func (d Distance) ScotsMiles() float64 {
+	base := float64(d)
+
+	return base / 1806.8544
+}
+
+// DistanceFromSmoots returns the Distance given a
+// value in units of "smoot"
+func DistanceFromSmoots(val float64) Distance {
+	return Distance(val * 1.7018)
+}
+
+// Smoots returns the Distance in units of
+// "smoot"
+func (d Distance) Smoots() float64 {
+	base := float64(d)
+
+	return base / 1.7018
+}
+
+// DistanceFromSwimmingMiles returns the Distance given a
+// value in units of "swimming-mile"
+func DistanceFromSwimmingMiles(val float64) Distance {
+	return Distance(val * 1600.0)
+}
+
+// SwimmingMiles returns the Distance in units of
+// "swimming-mile"
+func (d Distance) SwimmingMiles() float64 {
+	base := float64(d)
+
+	return base / 1600.0
+}
+
+// DistanceFromMicrometres returns the Distance given a
+// value in units of "um"
+func DistanceFromMicrometres(val float64) Distance {
+	return Distance(val * 1e-06)
+}
+
+// Micrometres returns the Distance in units of
+// "um"
+func (d Distance) Micrometres() float64 {
+	base := float64(d)
+
+	return base / 1e-06
+}
+
+// DistanceFromYards returns the Distance given a
+// value in units of "yard"
+func DistanceFromYards(val float64) Distance {
+	return Distance(val * 0.9144)
+}
+
+// Yards returns the Distance in units of
+// "yard"
+func (d Distance) Yards() float64 {
+	base := float64(d)
+
+	return base / 0.9144
+}
+
+// DistanceFromYoctometres returns the Distance given a
+// value in units of "ym"
+func DistanceFromYoctometres(val float64) Distance {
+	return Distance(val * 1e-24)
+}
+
+// Yoctometres returns the Distance in units of
+// "ym"
+func (d Distance) Yoctometres() float64 {
+	base := float64(d)
+
+	return base / 1e-24
+}
+
+// DistanceFromZeptometres returns the Distance given a
+// value in units of "zm"
+func DistanceFromZeptometres(val float64) Distance {
+	return Distance(val * 1e-21)
+}
+
+// Zeptometres returns the Distance in units of
+// "zm"
+func (d Distance) Zeptometres() float64 {
+	base := float64(d)
+
+	return base / 1e-21
+}
@@ -0,0 +1,25 @@
+/*
+Package typed provides a strongly typed quantity for each Family of units in
+the units package, such as Distance or Mass. Unlike a units.ValUnit, a
+Distance cannot be passed where a Mass is expected.
+
+Each quantity is a float64 held in the base units of its Family. There is a
+constructor and an accessor for each unit in the Family, for instance:
+
+	d := typed.DistanceFromFeet(3)
+	fmt.Println(d.Metres()) // 0.9144
+
+Quantities of the same type can be added, subtracted and scaled using the
+usual operators. Products and quotients of different quantities are given by
+methods returning the derived type, for instance:
+
+	v := d.DivTime(typed.TimeFromSeconds(2)) // a Velocity
+
+The code is generated from the units Family tables by the gentyped command
+and so the quantities are kept in step with the available units; do not
+edit the generated files. No quantity is given for the dimensionless Family;
+use a float64.
+*/
+package typed
+
+//go:generate go run ./internal/gentyped
@@ -0,0 +1,478 @@
+// Code generated by gentyped; DO NOT EDIT.
+
+package typed
+
+import "github.com/nickwells/units.mod/v2/units"
+
+// Energy is a energy held in joules, the base units of the
+// energy Family.
+type Energy float64
+
+// energyBaseUnit is the base unit of the energy Family
+var energyBaseUnit = units.GetOrPanic("energy", "joule")
+
+// EnergyFromValUnit returns the Energy having the value of the
+// ValUnit. It returns a non-nil error if the ValUnit is not a energy.
+func EnergyFromValUnit(vu units.ValUnit) (Energy, error) {
+	base, err := vu.Convert(energyBaseUnit)
+
+	return Energy(base.V), err
+}
+
+// ValUnit returns the Energy as a ValUnit in joules
+func (e Energy) ValUnit() units.ValUnit {
+	return units.ValUnit{V: float64(e), U: energyBaseUnit}
+}
+
+// String returns the Energy formatted as a ValUnit in joules
+func (e Energy) String() string {
+	return e.ValUnit().String()
+}
+
+// EnergyFromBritishThermalUnits returns the Energy given a
+// value in units of "BTU"
+func EnergyFromBritishThermalUnits(val float64) Energy {
+	return Energy(val * 1055.06)
+}
+
+// BritishThermalUnits returns the Energy in units of
+// "BTU"
+func (e Energy) BritishThermalUnits() float64 {
+	base := float64(e)
+
+	return base / 1055.06
+}
+
+// EnergyFromExajoules returns the Energy given a
+// value in units of "EJ"
+func EnergyFromExajoules(val float64) Energy {
+	return Energy(val * 1e+18)
+}
+
+// Exajoules returns the Energy in units of
+// "EJ"
+func (e Energy) Exajoules() float64 {
+	base := float64(e)
+
+	return base / 1e+18
+}
+
+// EnergyFromGigajoules returns the Energy given a
+// value in units of "GJ"
+func EnergyFromGigajoules(val float64) Energy {
+	return Energy(val * 1e+09)
+}
+
+// Gigajoules returns the Energy in units of
+// "GJ"
+func (e Energy) Gigajoules() float64 {
+	base := float64(e)
+
+	return base / 1e+09
+}
+
+// EnergyFromMegajoules returns the Energy given a
+// value in units of "MJ"
+func EnergyFromMegajoules(val float64) Energy {
+	return Energy(val * 1e+06)
+}
+
+// Megajoules returns the Energy in units of
+// "MJ"
+func (e Energy) Megajoules() float64 {
+	base := float64(e)
+
+	return base / 1e+06
+}
+
+// EnergyFromPetajoules returns the Energy given a
+// value in units of "PJ"
+func EnergyFromPetajoules(val float64) Energy {
+	return Energy(val * 1e+15)
+}
+
+// Petajoules returns the Energy in units of
+// "PJ"
+func (e Energy) Petajoules() float64 {
+	base := float64(e)
+
+	return base / 1e+15
+}
+
+// EnergyFromTerajoules returns the Energy given a
+// value in units of "TJ"
+func EnergyFromTerajoules(val float64) Energy {
+	return Energy(val * 1e+12)
+}
+
+// Terajoules returns the Energy in units of
+// "TJ"
+func (e Energy) Terajoules() float64 {
+	base := float64(e)
+
+	return base / 1e+12
+}
+
+// EnergyFromYottajoules returns the Energy given a
+// value in units of "YJ"
+func EnergyFromYottajoules(val float64) Energy {
+	return Energy(val * 1e+24)
+}
+
+// Yottajoules returns the Energy in units of
+// "YJ"
+func (e Energy) Yottajoules() float64 {
+	base := float64(e)
+
+	return base / 1e+24
+}
+
+// EnergyFromZettajoules returns the Energy given a
+// value in units of "ZJ"
+func EnergyFromZettajoules(val float64) Energy {
+	return Energy(val * 1e+21)
+}
+
+// Zettajoules returns the Energy in units of
+// "ZJ"
+func (e Energy) Zettajoules() float64 {
+	base := float64(e)
+
+	return base / 1e+21
+}
+
+// EnergyFromAttojoules returns the Energy given a
+// value in units of "aJ"
+func EnergyFromAttojoules(val float64) Energy {
+	return Energy(val * 1e-18)
+}
+
+// Attojoules returns the Energy in units of
+// "aJ"
+func (e Energy) Attojoules() float64 {
+	base := float64(e)
+
+	return base / 1e-18
+}
+
+// EnergyFromCentijoules returns the Energy given a
+// value in units of "cJ"
+func EnergyFromCentijoules(val float64) Energy {
+	return Energy(val * 0.01)
+}
+
+// Centijoules returns the Energy in units of
+// "cJ"
+func (e Energy) Centijoules() float64 {
+	base := float64(e)
+
+	return base / 0.01
+}
+
+// EnergyFromCalories returns the Energy given a
+// value in units of "cal"
+func EnergyFromCalories(val float64) Energy {
+	return Energy(val * 4.184)
+}
+
+// Calories returns the Energy in units of
+// "cal"
+func (e Energy) Calories() float64 {
+	base := float64(e)
+
+	return base / 4.184
+}
+
+// EnergyFromDecijoules returns the Energy given a
+// value in units of "dJ"
+func EnergyFromDecijoules(val float64) Energy {
+	return Energy(val * 0.1)
+}
+
+// Decijoules returns the Energy in units of
+// "dJ"
+func (e Energy) Decijoules() float64 {
+	base := float64(e)
+
+	return base / 0.1
+}
+
+// EnergyFromDecajoules returns the Energy given a
+// value in units of "daJ"
+func EnergyFromDecajoules(val float64) Energy {
+	return Energy(val * 10.0)
+}
+
+// Decajoules returns the Energy in units of
+// "daJ"
+func (e Energy) Decajoules() float64 {
+	base := float64(e)
+
+	return base / 10.0
+}
+
+// EnergyFromElectronVolts returns the Energy given a
+// value in units of "electronvolt"
+func EnergyFromElectronVolts(val float64) Energy {
+	return Energy(val * 1.602176634e-19)
+}
+
+// ElectronVolts returns the Energy in units of
+// "electronvolt"
+func (e Energy) ElectronVolts() float64 {
+	base := float64(e)
+
+	return base / 1.602176634e-19
+}
+
+// EnergyFromErgs returns the Energy given a
+// value in units of "erg"
+func EnergyFromErgs(val float64) Energy {
+	return Energy(val * 1e-07)
+}
+
+// Ergs returns the Energy in units of
+// "erg"
+func (e Energy) Ergs() float64 {
+	base := float64(e)
+
+	return base / 1e-07
+}
+
+// EnergyFromFemtojoules returns the Energy given a
+// value in units of "fJ"
+func EnergyFromFemtojoules(val float64) Energy {
+	return Energy(val * 1e-15)
+}
+
+// Femtojoules returns the Energy in units of
+// "fJ"
+func (e Energy) Femtojoules() float64 {
+	base := float64(e)
+
+	return base / 1e-15
+}
+
+// EnergyFromFoes returns the Energy given a
+// value in units of "foe"
+func EnergyFromFoes(val float64) Energy {
+	return Energy(val * 1e+44)
+}
+
+// Foes returns the Energy in units of
+// "foe"
+func (e Energy) Foes() float64 {
+	base := float64(e)
+
+	return base / 1e+44
+}
+
+// EnergyFromFootPounds returns the Energy given a
+// value in units of "foot-pound"
+func EnergyFromFootPounds(val float64) Energy {
+	return Energy(val * 1.3558179483314003)
+}
+
+// FootPounds returns the Energy in units of
+// "foot-pound"
+func (e Energy) FootPounds() float64 {
+	base := float64(e)
+
+	return base / 1.3558179483314003
+}
+
+// EnergyFromFootPoundals returns the Energy given a
+// value in units of "foot-poundal"
+func EnergyFromFootPoundals(val float64) Energy {
+	return Energy(val * 0.0421401100938048)
+}
+
+// FootPoundals returns the Energy in units of
+// "foot-poundal"
+func (e Energy) FootPoundals() float64 {
+	base := float64(e)
+
+	return base / 0.0421401100938048
+}
+
+// EnergyFromHectojoules returns the Energy given a
+// value in units of "hJ"
+func EnergyFromHectojoules(val float64) Energy {
+	return Energy(val * 100.0)
+}
+
+// Hectojoules returns the Energy in units of
+// "hJ"
+func (e Energy) Hectojoules() float64 {
+	base := float64(e)
+
+	return base / 100.0
+}
+
+// EnergyFromJoules returns the Energy given a
+// value in units of "joule"
+func EnergyFromJoules(val float64) Energy {
+	return Energy(val)
+}
+
+// Joules returns the Energy in units of
+// "joule"
+func (e Energy) Joules() float64 {
+	base := float64(e)
+
+	return base
+}
+
+// EnergyFromKilojoules returns the Energy given a
+// value in units of "kJ"
+func EnergyFromKilojoules(val float64) Energy {
+	return Energy(val * 1000.0)
+}
+
+// Kilojoules returns the Energy in units of
+// "kJ"
+func (e Energy) Kilojoules() float64 {
+	base := float64(e)
+
+	return base / 1000.0
+}
+
+// EnergyFromKillowattHours returns the Energy given a
+// value in units of "kWh"
+func EnergyFromKillowattHours(val float64) Energy {
+	return Energy(val * 3.6e+06)
+}
+
+// KillowattHours returns the Energy in units of
+// "kWh"
+func (e Energy) KillowattHours() float64 {
+	base := float64(e)
+
+	return base / 3.6e+06
+}
+
+// EnergyFromKilocalories returns the Energy given a
+// value in units of "kcal"
+func EnergyFromKilocalories(val float64) Energy {
+	return Energy(val * 4184.0)
+}
+
+// Kilocalories returns the Energy in units of
+// "kcal"
+func (e Energy) Kilocalories() float64 {
+	base := float64(e)
+
+	return base / 4184.0
+}
+
+// EnergyFromMillijoules returns the Energy given a
+// value in units of "mJ"
+func EnergyFromMillijoules(val float64) Energy {
+	return Energy(val * 0.001)
+}
+
+// Millijoules returns the Energy in units of
+// "mJ"
+func (e Energy) Millijoules() float64 {
+	base := float64(e)
+
+	return base / 0.001
+}
+
+// EnergyFromNanojoules returns the Energy given a
+// value in units of "nJ"
+func EnergyFromNanojoules(val float64) Energy {
+	return Energy(val * 1e-09)
+}
+
+// Nanojoules returns the Energy in units of
+// "nJ"
+func (e Energy) Nanojoules() float64 {
+	base := float64(e)
+
+	return base / 1e-09
+}
+
+// EnergyFromPicojoules returns the Energy given a
+// value in units of "pJ"
+func EnergyFromPicojoules(val float64) Energy {
+	return Energy(val * 1e-12)
+}
+
+// Picojoules returns the Energy in units of
+// "pJ"
+func (e Energy) Picojoules() float64 {
+	base := float64(e)
+
+	return base / 1e-12
+}
+
+// EnergyFromTherms returns the Energy given a
+// value in units of "therm"
+func EnergyFromTherms(val float64) Energy {
+	return Energy(val * 1.05506e+08)
+}
+
+// Therms returns the Energy in units of
+// "therm"
+func (e Energy) Therms() float64 {
+	base := float64(e)
+
+	return base / 1.05506e+08
+}
+
+// EnergyFromTonsOfTNT returns the Energy given a
+// value in units of "tonOfTNT"
+func EnergyFromTonsOfTNT(val float64) Energy {
+	return Energy(val * 4.184e+09)
+}
+
+// TonsOfTNT returns the Energy in units of
+// "tonOfTNT"
+func (e Energy) TonsOfTNT() float64 {
+	base := float64(e)
+
+	return base / 4.184e+09
+}
+
+// EnergyFromMicrojoules returns the Energy given a
+// value in units of "uJ"
+func EnergyFromMicrojoules(val float64) Energy {
+	return Energy(val * 1e-06)
+}
+
+// Microjoules returns the Energy in units of
+// "uJ"
+func (e Energy) Microjoules() float64 {
+	base := float64(e)
+
+	return base / 1e-06
+}
+
+// EnergyFromYoctojoules returns the Energy given a
+// value in units of "yJ"
+func EnergyFromYoctojoules(val float64) Energy {
+	return Energy(val * 1e-24)
+}
+
+// Yoctojoules returns the Energy in units of
+// "yJ"
+func (e Energy) Yoctojoules() float64 {
+	base := float64(e)
+
+	return base / 1e-24
+}
+
+// EnergyFromZeptojoules returns the Energy given a
+// value in units of "zJ"
+func EnergyFromZeptojoules(val float64) Energy {
+	return Energy(val * 1e-21)
+}
+
+// Zeptojoules returns the Energy in units of
+// "zJ"
+func (e Energy) Zeptojoules() float64 {
+	base := float64(e)
+
+	return base / 1e-21
+}
@@ -0,0 +1,526 @@
+// The gentyped command generates the typed quantities in the typed package
+// from the Family tables in the units package. It is run using go generate
+// from the typed package directory; see the doc.go file in that package.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/nickwells/units.mod/v2/units"
+)
+
+// arithmeticFileName is the name of the file holding the typed arithmetic
+const arithmeticFileName = "arithmetic_generated.go"
+
+// skippedFamilies holds the names of those Families for which no typed
+// quantity is generated. Dimensionless values are best held as a float64.
+var skippedFamilies = map[string]bool{
+	units.Dimensionless: true,
+}
+
+// coherentUnits gives, for each Family used in the typed arithmetic, the
+// name of the coherent SI unit. The products and quotients are correct when
+// calculated in these units and are scaled as necessary for the base units.
+var coherentUnits = map[string]string{
+	units.Distance: "metre",
+	units.Area:     "square metre",
+	units.Volume:   "cubic metre",
+	units.Time:     "second",
+	units.Velocity: "metre/second",
+	units.Pressure: "pascal",
+	units.Energy:   "joule",
+}
+
+// relation describes an arithmetic operation on two typed quantities
+// giving a third
+type relation struct {
+	op     byte
+	a, b   string
+	result string
+}
+
+// relations lists the typed arithmetic to generate
+var relations = []relation{
+	{'*', units.Distance, units.Distance, units.Area},
+	{'*', units.Distance, units.Area, units.Volume},
+	{'*', units.Area, units.Distance, units.Volume},
+	{'/', units.Area, units.Distance, units.Distance},
+	{'/', units.Volume, units.Distance, units.Area},
+	{'/', units.Volume, units.Area, units.Distance},
+	{'/', units.Distance, units.Time, units.Velocity},
+	{'/', units.Distance, units.Velocity, units.Time},
+	{'*', units.Velocity, units.Time, units.Distance},
+	{'*', units.Time, units.Velocity, units.Distance},
+	{'*', units.Pressure, units.Volume, units.Energy},
+	{'*', units.Volume, units.Pressure, units.Energy},
+	{'/', units.Energy, units.Volume, units.Pressure},
+	{'/', units.Energy, units.Pressure, units.Volume},
+}
+
+// reservedMethods holds the names of the methods of the typed quantities
+// which are not unit accessors
+var reservedMethods = map[string]bool{
+	"String":  true,
+	"ValUnit": true,
+}
+
+// asciiFolds maps non-ASCII letters found in unit names to ASCII so that
+// the generated identifiers are easy to type. A slash is given as "per" so
+// that, for instance, "metres/second" gives "MetresPerSecond".
+var asciiFolds = strings.NewReplacer(
+	"/", " per ",
+	"ø", "o",
+	"é", "e",
+	"µ", "micro",
+)
+
+// words splits the string into words of letters and digits, with any
+// non-ASCII letters folded into ASCII
+func words(s string) []string {
+	return strings.FieldsFunc(asciiFolds.Replace(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// goIdent returns the words joined into an exported Go identifier. It
+// returns an error if this is not possible.
+func goIdent(ws []string) (string, error) {
+	var sb strings.Builder
+
+	for _, w := range ws {
+		r := []rune(w)
+		r[0] = unicode.ToUpper(r[0])
+		sb.WriteString(string(r))
+	}
+
+	id := sb.String()
+	if id == "" {
+		return "", errors.New("the identifier is empty")
+	}
+
+	for i, r := range id {
+		if r > unicode.MaxASCII {
+			return "", fmt.Errorf("%q has a non-ASCII character", id)
+		}
+
+		if i == 0 && !unicode.IsUpper(r) {
+			return "", fmt.Errorf("%q does not start with a capital letter", id)
+		}
+	}
+
+	return id, nil
+}
+
+// pluralIdent returns the identifier for the unit derived from its plural
+// name, for instance "Feet"
+func pluralIdent(u units.Unit) (string, error) {
+	return goIdent(words(u.NamePlural()))
+}
+
+// idIdent returns the identifier for the unit derived from its ID with the
+// last word made plural if the ID ends with the unit name, for instance
+// "USSurveyFeet"
+func idIdent(u units.Unit) (string, error) {
+	idWords := words(u.ID())
+	nameWords := words(u.Name())
+	pluralWords := words(u.NamePlural())
+
+	if len(idWords) > 0 && len(nameWords) > 0 && len(pluralWords) > 0 &&
+		idWords[len(idWords)-1] == nameWords[len(nameWords)-1] {
+		idWords[len(idWords)-1] = pluralWords[len(pluralWords)-1]
+	}
+
+	return goIdent(idWords)
+}
+
+// unitInfo holds the details of a unit needed to generate its
+// constructor and accessor
+type unitInfo struct {
+	ID     string
+	Ident  string
+	ToBase string // converts the float64 "val" into base units
+	In     string // converts the float64 "base" into this unit
+}
+
+// fmtFloat formats the value as a Go floating point literal
+func fmtFloat(v float64) string {
+	s := strconv.FormatFloat(v, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".eE") {
+		s += ".0"
+	}
+
+	return s
+}
+
+// addTerm returns the expression with the value added
+func addTerm(expr string, v float64) string {
+	switch {
+	case v > 0:
+		return expr + " + " + fmtFloat(v)
+	case v < 0:
+		return expr + " - " + fmtFloat(-v)
+	}
+
+	return expr
+}
+
+// subTerm returns the expression with the value subtracted
+func subTerm(expr string, v float64) string {
+	return addTerm(expr, -v)
+}
+
+// conversions returns the expressions converting a value into and out of
+// the base units. These follow the conversion in the units package:
+//
+//	base = ((val - postAdd) * factor) - preAdd
+func conversions(u units.Unit) (toBase, in string) {
+	toBase = subTerm("val", u.ConvPostAdd())
+	if u.ConvFactor() != 1 {
+		if toBase != "val" {
+			toBase = "(" + toBase + ")"
+		}
+
+		toBase += " * " + fmtFloat(u.ConvFactor())
+	}
+
+	toBase = subTerm(toBase, u.ConvPreAdd())
+
+	in = addTerm("base", u.ConvPreAdd())
+	if u.ConvFactor() != 1 {
+		if in != "base" {
+			in = "(" + in + ")"
+		}
+
+		in += " / " + fmtFloat(u.ConvFactor())
+	}
+
+	in = addTerm(in, u.ConvPostAdd())
+
+	return toBase, in
+}
+
+// familyInfo holds the details of a Family needed to generate its typed
+// quantity
+type familyInfo struct {
+	Name     string
+	Type     string
+	Recv     string
+	BaseVar  string
+	BaseUnit string
+	BaseDesc string
+	Units    []unitInfo
+}
+
+// unitIdents returns the identifiers for the units, resolving any clashes
+// between the plural names by using the unit IDs.
+func unitIdents(us []units.Unit) (map[string]string, error) {
+	byIdent := map[string][]units.Unit{}
+
+	for _, u := range us {
+		id, err := pluralIdent(u)
+		if err != nil {
+			return nil, fmt.Errorf("unit %q: %w", u.ID(), err)
+		}
+
+		byIdent[id] = append(byIdent[id], u)
+	}
+
+	idents := map[string]string{}
+	used := map[string]string{}
+
+	for id, clashing := range byIdent {
+		for _, u := range clashing {
+			if len(clashing) > 1 {
+				var err error
+
+				id, err = idIdent(u)
+				if err != nil {
+					return nil, fmt.Errorf("unit %q: %w", u.ID(), err)
+				}
+			}
+
+			if reservedMethods[id] {
+				return nil, fmt.Errorf("unit %q: %q is a reserved method name",
+					u.ID(), id)
+			}
+
+			if other, ok := used[id]; ok {
+				return nil, fmt.Errorf("units %q and %q both have the name %q",
+					other, u.ID(), id)
+			}
+
+			idents[u.ID()] = id
+			used[id] = u.ID()
+		}
+	}
+
+	return idents, nil
+}
+
+// newFamilyInfo returns the details of the Family
+func newFamilyInfo(f *units.Family) (familyInfo, error) {
+	typeName, err := goIdent(words(f.Name()))
+	if err != nil {
+		return familyInfo{}, fmt.Errorf("family %q: %w", f.Name(), err)
+	}
+
+	us := f.GetUnits()
+	slices.SortFunc(us, func(a, b units.Unit) int {
+		return strings.Compare(a.ID(), b.ID())
+	})
+
+	idents, err := unitIdents(us)
+	if err != nil {
+		return familyInfo{}, fmt.Errorf("family %q: %w", f.Name(), err)
+	}
+
+	fi := familyInfo{
+		Name:     f.Name(),
+		Type:     typeName,
+		Recv:     strings.ToLower(typeName[:1]),
+		BaseVar:  strings.ToLower(typeName[:1]) + typeName[1:] + "BaseUnit",
+		BaseUnit: f.BaseUnitName(),
+	}
+
+	base, err := f.GetUnitStrict(f.BaseUnitName())
+	if err != nil {
+		return familyInfo{}, fmt.Errorf("family %q: %w", f.Name(), err)
+	}
+
+	fi.BaseDesc = base.NamePlural()
+
+	for _, u := range us {
+		toBase, in := conversions(u)
+		fi.Units = append(fi.Units, unitInfo{
+			ID:     u.ID(),
+			Ident:  idents[u.ID()],
+			ToBase: toBase,
+			In:     in,
+		})
+	}
+
+	return fi, nil
+}
+
+// arithInfo holds the details of a relation needed to generate its method
+type arithInfo struct {
+	Method string
+	Recv   string
+	A      string
+	B      string
+	Result string
+	Op     string
+	Verb   string
+	Scale  string
+}
+
+// coherentFactor returns the conversion factor of the coherent unit of the
+// Family
+func coherentFactor(fName string) (float64, error) {
+	uName, ok := coherentUnits[fName]
+	if !ok {
+		return 0, fmt.Errorf("there is no coherent unit of %s", fName)
+	}
+
+	u, err := units.GetStrict(fName, uName)
+	if err != nil {
+		return 0, err
+	}
+
+	if u.ConvPreAdd() != 0 || u.ConvPostAdd() != 0 {
+		return 0, fmt.Errorf("the coherent unit %q has an offset", uName)
+	}
+
+	return u.ConvFactor(), nil
+}
+
+// newArithInfo returns the details of the relation
+func newArithInfo(r relation) (arithInfo, error) {
+	var (
+		factors [3]float64
+		types   [3]string
+	)
+
+	for i, fName := range []string{r.a, r.b, r.result} {
+		var err error
+
+		if factors[i], err = coherentFactor(fName); err != nil {
+			return arithInfo{}, err
+		}
+
+		if types[i], err = goIdent(words(fName)); err != nil {
+			return arithInfo{}, err
+		}
+	}
+
+	ai := arithInfo{
+		Recv:   strings.ToLower(types[0][:1]),
+		A:      types[0],
+		B:      types[1],
+		Result: types[2],
+	}
+
+	var scale float64
+
+	switch r.op {
+	case '*':
+		ai.Method, ai.Op, ai.Verb = "Mul"+types[1], "*", "multiplying"
+		scale = factors[2] / (factors[0] * factors[1])
+	case '/':
+		ai.Method, ai.Op, ai.Verb = "Div"+types[1], "/", "dividing"
+		scale = factors[2] * factors[1] / factors[0]
+	default:
+		return arithInfo{}, fmt.Errorf("bad operator: %q", r.op)
+	}
+
+	if scale != 1 {
+		ai.Scale = " * " + fmtFloat(scale)
+	}
+
+	return ai, nil
+}
+
+const generatedHeader = `// Code generated by gentyped; DO NOT EDIT.
+
+package typed
+`
+
+var familyTmpl = template.Must(template.New("family").Parse(generatedHeader + `
+import "github.com/nickwells/units.mod/v2/units"
+
+// {{.Type}} is a {{.Name}} held in {{.BaseDesc}}, the base units of the
+// {{.Name}} Family.
+type {{.Type}} float64
+
+// {{.BaseVar}} is the base unit of the {{.Name}} Family
+var {{.BaseVar}} = units.GetOrPanic("{{.Name}}", "{{.BaseUnit}}")
+
+// {{.Type}}FromValUnit returns the {{.Type}} having the value of the
+// ValUnit. It returns a non-nil error if the ValUnit is not a {{.Name}}.
+func {{.Type}}FromValUnit(vu units.ValUnit) ({{.Type}}, error) {
+	base, err := vu.Convert({{.BaseVar}})
+
+	return {{.Type}}(base.V), err
+}
+
+// ValUnit returns the {{.Type}} as a ValUnit in {{.BaseDesc}}
+func ({{.Recv}} {{.Type}}) ValUnit() units.ValUnit {
+	return units.ValUnit{V: float64({{.Recv}}), U: {{.BaseVar}}}
+}
+
+// String returns the {{.Type}} formatted as a ValUnit in {{.BaseDesc}}
+func ({{.Recv}} {{.Type}}) String() string {
+	return {{.Recv}}.ValUnit().String()
+}
+{{range .Units}}
+// {{$.Type}}From{{.Ident}} returns the {{$.Type}} given a
+// value in units of {{printf "%q" .ID}}
+func {{$.Type}}From{{.Ident}}(val float64) {{$.Type}} {
+	return {{$.Type}}({{.ToBase}})
+}
+
+// {{.Ident}} returns the {{$.Type}} in units of
+// {{printf "%q" .ID}}
+func ({{$.Recv}} {{$.Type}}) {{.Ident}}() float64 {
+	base := float64({{$.Recv}})
+
+	return {{.In}}
+}
+{{end}}`))
+
+var arithTmpl = template.Must(template.New("arith").Parse(generatedHeader + `{{range .}}
+// {{.Method}} returns the {{.Result}} given by {{.Verb}} the {{.A}} by other
+func ({{.Recv}} {{.A}}) {{.Method}}(other {{.B}}) {{.Result}} {
+	return {{.Result}}(float64({{.Recv}}) {{.Op}} float64(other){{.Scale}})
+}
+{{end}}`))
+
+// execute runs the template and returns the formatted source
+func execute(tmpl *template.Template, data any) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+// familyFileName returns the name of the file holding the generated code
+// for the Family
+func familyFileName(f *units.Family) string {
+	return strings.Join(words(f.Name()), "_") + "_generated.go"
+}
+
+// generate returns the generated source files, keyed by file name
+func generate() (map[string][]byte, error) {
+	files := map[string][]byte{}
+
+	for _, f := range units.GetFamilies() {
+		if skippedFamilies[f.Name()] {
+			continue
+		}
+
+		fi, err := newFamilyInfo(f)
+		if err != nil {
+			return nil, err
+		}
+
+		src, err := execute(familyTmpl, fi)
+		if err != nil {
+			return nil, fmt.Errorf("family %q: %w", f.Name(), err)
+		}
+
+		files[familyFileName(f)] = src
+	}
+
+	arith := make([]arithInfo, 0, len(relations))
+
+	for _, r := range relations {
+		ai, err := newArithInfo(r)
+		if err != nil {
+			return nil, err
+		}
+
+		arith = append(arith, ai)
+	}
+
+	src, err := execute(arithTmpl, arith)
+	if err != nil {
+		return nil, err
+	}
+
+	files[arithmeticFileName] = src
+
+	return files, nil
+}
+
+func main() {
+	dir := flag.String("dir", ".", "the directory to write the files to")
+	flag.Parse()
+
+	files, err := generate()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "gentyped:", err)
+		os.Exit(1)
+	}
+
+	for name, src := range files {
+		err := os.WriteFile(filepath.Join(*dir, name), src, 0o644) //nolint:gosec
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "gentyped:", err)
+			os.Exit(1)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+	"github.com/nickwells/units.mod/v2/units"
+)
+
+// typedDir is the directory holding the generated files
+const typedDir = "../.."
+
+// TestGeneratedFiles checks that the generated files in the typed package
+// are in step with the units Family tables. If this fails, run go generate
+// in the typed package directory.
+func TestGeneratedFiles(t *testing.T) {
+	files, err := generate()
+	if err != nil {
+		t.Fatal("cannot generate the files:", err)
+	}
+
+	onDisk, err := filepath.Glob(filepath.Join(typedDir, "*_generated.go"))
+	if err != nil {
+		t.Fatal("cannot find the generated files:", err)
+	}
+
+	for _, path := range onDisk {
+		if _, ok := files[filepath.Base(path)]; !ok {
+			t.Errorf("%s is not generated by gentyped", path)
+		}
+	}
+
+	for name, src := range files {
+		content, err := os.ReadFile(filepath.Join(typedDir, name)) //nolint:gosec
+		if err != nil {
+			t.Errorf("cannot read %s: %s", name, err)
+			continue
+		}
+
+		testhelper.DiffString(t, name, "contents", string(content), string(src))
+	}
+}
+
+func TestConversions(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		u         units.Unit
+		expToBase string
+		expIn     string
+	}{
+		{
+			ID:        testhelper.MkID("base unit"),
+			u:         units.GetOrPanic(units.Distance, "metre"),
+			expToBase: "val",
+			expIn:     "base",
+		},
+		{
+			ID:        testhelper.MkID("factor"),
+			u:         units.GetOrPanic(units.Distance, "foot"),
+			expToBase: "val * 0.3048",
+			expIn:     "base / 0.3048",
+		},
+		{
+			ID:        testhelper.MkID("pre-add"),
+			u:         units.GetOrPanic(units.Temperature, "kelvin"),
+			expToBase: "val - 273.15",
+			expIn:     "base + 273.15",
+		},
+		{
+			ID:        testhelper.MkID("factor and post-add"),
+			u:         units.GetOrPanic(units.Temperature, "Fahrenheit"),
+			expToBase: "(val - 32.0) * 0.5555555555555556",
+			expIn:     "base / 0.5555555555555556 + 32.0",
+		},
+	}
+
+	for _, tc := range testCases {
+		toBase, in := conversions(tc.u)
+		testhelper.DiffString(t, tc.IDStr(), "to base", toBase, tc.expToBase)
+		testhelper.DiffString(t, tc.IDStr(), "in", in, tc.expIn)
+	}
+}
+
+func TestUnitIdents(t *testing.T) {
+	f := units.GetFamilyOrPanic(units.Distance)
+
+	idents, err := unitIdents(f.GetUnits())
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	feet := []string{}
+
+	for _, id := range []string{"foot", "US survey foot", "Indian survey foot"} {
+		feet = append(feet, idents[id])
+	}
+
+	testhelper.DiffStringSlice(t, "feet", "identifiers",
+		feet, []string{"Feet", "USSurveyFeet", "IndianSurveyFeet"})
+
+	all := []string{}
+	for _, id := range idents {
+		all = append(all, id)
+	}
+
+	slices.Sort(all)
+	testhelper.DiffInt(t, "all", "unique identifiers",
+		len(slices.Compact(all)), len(idents))
+}
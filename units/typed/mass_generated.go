@@ -0,0 +1,632 @@
+// Code generated by gentyped; DO NOT EDIT.
+
+package typed
+
+import "github.com/nickwells/units.mod/v2/units"
+
+// Mass is a mass held in grams, the base units of the
+// mass Family.
+type Mass float64
+
+// massBaseUnit is the base unit of the mass Family
+var massBaseUnit = units.GetOrPanic("mass", "gram")
+
+// MassFromValUnit returns the Mass having the value of the
+// ValUnit. It returns a non-nil error if the ValUnit is not a mass.
+func MassFromValUnit(vu units.ValUnit) (Mass, error) {
+	base, err := vu.Convert(massBaseUnit)
+
+	return Mass(base.V), err
+}
+
+// ValUnit returns the Mass as a ValUnit in grams
+func (m Mass) ValUnit() units.ValUnit {
+	return units.ValUnit{V: float64(m), U: massBaseUnit}
+}
+
+// String returns the Mass formatted as a ValUnit in grams
+func (m Mass) String() string {
+	return m.ValUnit().String()
+}
+
+// MassFromExagrams returns the Mass given a
+// value in units of "Eg"
+func MassFromExagrams(val float64) Mass {
+	return Mass(val * 1e+18)
+}
+
+// Exagrams returns the Mass in units of
+// "Eg"
+func (m Mass) Exagrams() float64 {
+	base := float64(m)
+
+	return base / 1e+18
+}
+
+// MassFromPetagrams returns the Mass given a
+// value in units of "Pg"
+func MassFromPetagrams(val float64) Mass {
+	return Mass(val * 1e+15)
+}
+
+// Petagrams returns the Mass in units of
+// "Pg"
+func (m Mass) Petagrams() float64 {
+	base := float64(m)
+
+	return base / 1e+15
+}
+
+// MassFromTeragrams returns the Mass given a
+// value in units of "Tg"
+func MassFromTeragrams(val float64) Mass {
+	return Mass(val * 1e+12)
+}
+
+// Teragrams returns the Mass in units of
+// "Tg"
+func (m Mass) Teragrams() float64 {
+	base := float64(m)
+
+	return base / 1e+12
+}
+
+// MassFromYottagrams returns the Mass given a
+// value in units of "Yg"
+func MassFromYottagrams(val float64) Mass {
+	return Mass(val * 1e+24)
+}
+
+// Yottagrams returns the Mass in units of
+// "Yg"
+func (m Mass) Yottagrams() float64 {
+	base := float64(m)
+
+	return base / 1e+24
+}
+
+// MassFromZettagrams returns the Mass given a
+// value in units of "Zg"
+func MassFromZettagrams(val float64) Mass {
+	return Mass(val * 1e+21)
+}
+
+// Zettagrams returns the Mass in units of
+// "Zg"
+func (m Mass) Zettagrams() float64 {
+	base := float64(m)
+
+	return base / 1e+21
+}
+
+// MassFromAttograms returns the Mass given a
+// value in units of "ag"
+func MassFromAttograms(val float64) Mass {
+	return Mass(val * 1e-18)
+}
+
+// Attograms returns the Mass in units of
+// "ag"
+func (m Mass) Attograms() float64 {
+	base := float64(m)
+
+	return base / 1e-18
+}
+
+// MassFromCentigrams returns the Mass given a
+// value in units of "cg"
+func MassFromCentigrams(val float64) Mass {
+	return Mass(val * 0.01)
+}
+
+// Centigrams returns the Mass in units of
+// "cg"
+func (m Mass) Centigrams() float64 {
+	base := float64(m)
+
+	return base / 0.01
+}
+
+// MassFromDecagrams returns the Mass given a
+// value in units of "dag"
+func MassFromDecagrams(val float64) Mass {
+	return Mass(val * 10.0)
+}
+
+// Decagrams returns the Mass in units of
+// "dag"
+func (m Mass) Decagrams() float64 {
+	base := float64(m)
+
+	return base / 10.0
+}
+
+// MassFromDaltons returns the Mass given a
+// value in units of "dalton"
+func MassFromDaltons(val float64) Mass {
+	return Mass(val * 1.66053906892e-24)
+}
+
+// Daltons returns the Mass in units of
+// "dalton"
+func (m Mass) Daltons() float64 {
+	base := float64(m)
+
+	return base / 1.66053906892e-24
+}
+
+// MassFromDecigrams returns the Mass given a
+// value in units of "dg"
+func MassFromDecigrams(val float64) Mass {
+	return Mass(val * 0.1)
+}
+
+// Decigrams returns the Mass in units of
+// "dg"
+func (m Mass) Decigrams() float64 {
+	base := float64(m)
+
+	return base / 0.1
+}
+
+// MassFromDrachms returns the Mass given a
+// value in units of "drachm"
+func MassFromDrachms(val float64) Mass {
+	return Mass(val * 3.8879346)
+}
+
+// Drachms returns the Mass in units of
+// "drachm"
+func (m Mass) Drachms() float64 {
+	base := float64(m)
+
+	return base / 3.8879346
+}
+
+// MassFromDrams returns the Mass given a
+// value in units of "dram"
+func MassFromDrams(val float64) Mass {
+	return Mass(val * 3.8879346)
+}
+
+// Drams returns the Mass in units of
+// "dram"
+func (m Mass) Drams() float64 {
+	base := float64(m)
+
+	return base / 3.8879346
+}
+
+// MassFromEarthMasses returns the Mass given a
+// value in units of "earth-mass"
+func MassFromEarthMasses(val float64) Mass {
+	return Mass(val * 5.9722e+27)
+}
+
+// EarthMasses returns the Mass in units of
+// "earth-mass"
+func (m Mass) EarthMasses() float64 {
+	base := float64(m)
+
+	return base / 5.9722e+27
+}
+
+// MassFromElectronVolts returns the Mass given a
+// value in units of "electronvolt"
+func MassFromElectronVolts(val float64) Mass {
+	return Mass(val * 1.782661921627898e-36)
+}
+
+// ElectronVolts returns the Mass in units of
+// "electronvolt"
+func (m Mass) ElectronVolts() float64 {
+	base := float64(m)
+
+	return base / 1.782661921627898e-36
+}
+
+// MassFromFemtograms returns the Mass given a
+// value in units of "fg"
+func MassFromFemtograms(val float64) Mass {
+	return Mass(val * 1e-15)
+}
+
+// Femtograms returns the Mass in units of
+// "fg"
+func (m Mass) Femtograms() float64 {
+	base := float64(m)
+
+	return base / 1e-15
+}
+
+// MassFromGigaElectronVolts returns the Mass given a
+// value in units of "gigaelectronvolt"
+func MassFromGigaElectronVolts(val float64) Mass {
+	return Mass(val * 1.7826619216278976e-27)
+}
+
+// GigaElectronVolts returns the Mass in units of
+// "gigaelectronvolt"
+func (m Mass) GigaElectronVolts() float64 {
+	base := float64(m)
+
+	return base / 1.7826619216278976e-27
+}
+
+// MassFromGrains returns the Mass given a
+// value in units of "grain"
+func MassFromGrains(val float64) Mass {
+	return Mass(val * 0.06479891)
+}
+
+// Grains returns the Mass in units of
+// "grain"
+func (m Mass) Grains() float64 {
+	base := float64(m)
+
+	return base / 0.06479891
+}
+
+// MassFromGrams returns the Mass given a
+// value in units of "gram"
+func MassFromGrams(val float64) Mass {
+	return Mass(val)
+}
+
+// Grams returns the Mass in units of
+// "gram"
+func (m Mass) Grams() float64 {
+	base := float64(m)
+
+	return base
+}
+
+// MassFromHectograms returns the Mass given a
+// value in units of "hg"
+func MassFromHectograms(val float64) Mass {
+	return Mass(val * 100.0)
+}
+
+// Hectograms returns the Mass in units of
+// "hg"
+func (m Mass) Hectograms() float64 {
+	base := float64(m)
+
+	return base / 100.0
+}
+
+// MassFromHundredweight returns the Mass given a
+// value in units of "hundredweight"
+func MassFromHundredweight(val float64) Mass {
+	return Mass(val * 50802.34544)
+}
+
+// Hundredweight returns the Mass in units of
+// "hundredweight"
+func (m Mass) Hundredweight() float64 {
+	base := float64(m)
+
+	return base / 50802.34544
+}
+
+// MassFromImperialTons returns the Mass given a
+// value in units of "imperial-ton"
+func MassFromImperialTons(val float64) Mass {
+	return Mass(val * 1.0160469088e+06)
+}
+
+// ImperialTons returns the Mass in units of
+// "imperial-ton"
+func (m Mass) ImperialTons() float64 {
+	base := float64(m)
+
+	return base / 1.0160469088e+06
+}
+
+// MassFromKilograms returns the Mass given a
+// value in units of "kg"
+func MassFromKilograms(val float64) Mass {
+	return Mass(val * 1000.0)
+}
+
+// Kilograms returns the Mass in units of
+// "kg"
+func (m Mass) Kilograms() float64 {
+	base := float64(m)
+
+	return base / 1000.0
+}
+
+// MassFromKilodaltons returns the Mass given a
+// value in units of "kilodalton"
+func MassFromKilodaltons(val float64) Mass {
+	return Mass(val * 1.66053906892e-21)
+}
+
+// Kilodaltons returns the Mass in units of
+// "kilodalton"
+func (m Mass) Kilodaltons() float64 {
+	base := float64(m)
+
+	return base / 1.66053906892e-21
+}
+
+// MassFromKilotonnes returns the Mass given a
+// value in units of "kilotonne"
+func MassFromKilotonnes(val float64) Mass {
+	return Mass(val * 1e+09)
+}
+
+// Kilotonnes returns the Mass in units of
+// "kilotonne"
+func (m Mass) Kilotonnes() float64 {
+	base := float64(m)
+
+	return base / 1e+09
+}
+
+// MassFromLunarMasses returns the Mass given a
+// value in units of "lunar-mass"
+func MassFromLunarMasses(val float64) Mass {
+	return Mass(val * 7.342e+25)
+}
+
+// LunarMasses returns the Mass in units of
+// "lunar-mass"
+func (m Mass) LunarMasses() float64 {
+	base := float64(m)
+
+	return base / 7.342e+25
+}
+
+// MassFromMegadaltons returns the Mass given a
+// value in units of "megadalton"
+func MassFromMegadaltons(val float64) Mass {
+	return Mass(val * 1.66053906892e-18)
+}
+
+// Megadaltons returns the Mass in units of
+// "megadalton"
+func (m Mass) Megadaltons() float64 {
+	base := float64(m)
+
+	return base / 1.66053906892e-18
+}
+
+// MassFromMegatonnes returns the Mass given a
+// value in units of "megatonne"
+func MassFromMegatonnes(val float64) Mass {
+	return Mass(val * 1e+12)
+}
+
+// Megatonnes returns the Mass in units of
+// "megatonne"
+func (m Mass) Megatonnes() float64 {
+	base := float64(m)
+
+	return base / 1e+12
+}
+
+// MassFromMilligrams returns the Mass given a
+// value in units of "mg"
+func MassFromMilligrams(val float64) Mass {
+	return Mass(val * 0.001)
+}
+
+// Milligrams returns the Mass in units of
+// "mg"
+func (m Mass) Milligrams() float64 {
+	base := float64(m)
+
+	return base / 0.001
+}
+
+// MassFromMyriagrams returns the Mass given a
+// value in units of "myg"
+func MassFromMyriagrams(val float64) Mass {
+	return Mass(val * 10000.0)
+}
+
+// Myriagrams returns the Mass in units of
+// "myg"
+func (m Mass) Myriagrams() float64 {
+	base := float64(m)
+
+	return base / 10000.0
+}
+
+// MassFromNanograms returns the Mass given a
+// value in units of "ng"
+func MassFromNanograms(val float64) Mass {
+	return Mass(val * 1e-09)
+}
+
+// Nanograms returns the Mass in units of
+// "ng"
+func (m Mass) Nanograms() float64 {
+	base := float64(m)
+
+	return base / 1e-09
+}
+
+// MassFromOunces returns the Mass given a
+// value in units of "ounce"
+func MassFromOunces(val float64) Mass {
+	return Mass(val * 28.349523125)
+}
+
+// Ounces returns the Mass in units of
+// "ounce"
+func (m Mass) Ounces() float64 {
+	base := float64(m)
+
+	return base / 28.349523125
+}
+
+// MassFromPicograms returns the Mass given a
+// value in units of "pg"
+func MassFromPicograms(val float64) Mass {
+	return Mass(val * 1e-12)
+}
+
+// Picograms returns the Mass in units of
+// "pg"
+func (m Mass) Picograms() float64 {
+	base := float64(m)
+
+	return base / 1e-12
+}
+
+// MassFromPounds returns the Mass given a
+// value in units of "pound"
+func MassFromPounds(val float64) Mass {
+	return Mass(val * 453.59237)
+}
+
+// Pounds returns the Mass in units of
+// "pound"
+func (m Mass) Pounds() float64 {
+	base := float64(m)
+
+	return base / 453.59237
+}
+
+// MassFromScruples returns the Mass given a
+// value in units of "scruple"
+func MassFromScruples(val float64) Mass {
+	return Mass(val * 1.2959782)
+}
+
+// Scruples returns the Mass in units of
+// "scruple"
+func (m Mass) Scruples() float64 {
+	base := float64(m)
+
+	return base / 1.2959782
+}
+
+// MassFromShortHundredweight returns the Mass given a
+// value in units of "short-hundredweight"
+func MassFromShortHundredweight(val float64) Mass {
+	return Mass(val * 45359.237)
+}
+
+// ShortHundredweight returns the Mass in units of
+// "short-hundredweight"
+func (m Mass) ShortHundredweight() float64 {
+	base := float64(m)
+
+	return base / 45359.237
+}
+
+// MassFromShortTons returns the Mass given a
+// value in units of "short-ton"
+func MassFromShortTons(val float64) Mass {
+	return Mass(val * 907184.74)
+}
+
+// ShortTons returns the Mass in units of
+// "short-ton"
+func (m Mass) ShortTons() float64 {
+	base := float64(m)
+
+	return base / 907184.74
+}
+
+// MassFromSolarMasses returns the Mass given a
+// value in units of "solar-mass"
+func MassFromSolarMasses(val float64) Mass {
+	return Mass(val * 1.98847e+33)
+}
+
+// SolarMasses returns the Mass in units of
+// "solar-mass"
+func (m Mass) SolarMasses() float64 {
+	base := float64(m)
+
+	return base / 1.98847e+33
+}
+
+// MassFromStones returns the Mass given a
+// value in units of "stone"
+func MassFromStones(val float64) Mass {
+	return Mass(val * 6350.29318)
+}
+
+// Stones returns the Mass in units of
+// "stone"
+func (m Mass) Stones() float64 {
+	base := float64(m)
+
+	return base / 6350.29318
+}
+
+// MassFromTonnes returns the Mass given a
+// value in units of "tonne"
+func MassFromTonnes(val float64) Mass {
+	return Mass(val * 1e+06)
+}
+
+// Tonnes returns the Mass in units of
+// "tonne"
+func (m Mass) Tonnes() float64 {
+	base := float64(m)
+
+	return base / 1e+06
+}
+
+// MassFromTroyOunces returns the Mass given a
+// value in units of "troy-ounce"
+func MassFromTroyOunces(val float64) Mass {
+	return Mass(val * 31.1034768)
+}
+
+// TroyOunces returns the Mass in units of
+// "troy-ounce"
+func (m Mass) TroyOunces() float64 {
+	base := float64(m)
+
+	return base / 31.1034768
+}
+
+// MassFromMicrograms returns the Mass given a
+// value in units of "ug"
+func MassFromMicrograms(val float64) Mass {
+	return Mass(val * 1e-06)
+}
+
+// Micrograms returns the Mass in units of
+// "ug"
+func (m Mass) Micrograms() float64 {
+	base := float64(m)
+
+	return base / 1e-06
+}
+
+// MassFromYoctograms returns the Mass given a
+// value in units of "yg"
+func MassFromYoctograms(val float64) Mass {
+	return Mass(val * 1e-24)
+}
+
+// Yoctograms returns the Mass in units of
+// "yg"
+func (m Mass) Yoctograms() float64 {
+	base := float64(m)
+
+	return base / 1e-24
+}
+
+// MassFromZeptograms returns the Mass given a
+// value in units of "zg"
+func MassFromZeptograms(val float64) Mass {
+	return Mass(val * 1e-21)
+}
+
+// Zeptograms returns the Mass in units of
+// "zg"
+func (m Mass) Zeptograms() float64 {
+	base := float64(m)
+
+	return base / 1e-21
+}
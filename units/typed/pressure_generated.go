@@ -0,0 +1,380 @@
+// Code generated by gentyped; DO NOT EDIT.
+
+package typed
+
+import "github.com/nickwells/units.mod/v2/units"
+
+// Pressure is a pressure held in pascals, the base units of the
+// pressure Family.
+type Pressure float64
+
+// pressureBaseUnit is the base unit of the pressure Family
+var pressureBaseUnit = units.GetOrPanic("pressure", "pascal")
+
+// PressureFromValUnit returns the Pressure having the value of the
+// ValUnit. It returns a non-nil error if the ValUnit is not a pressure.
+func PressureFromValUnit(vu units.ValUnit) (Pressure, error) {
+	base, err := vu.Convert(pressureBaseUnit)
+
+	return Pressure(base.V), err
+}
+
+// ValUnit returns the Pressure as a ValUnit in pascals
+func (p Pressure) ValUnit() units.ValUnit {
+	return units.ValUnit{V: float64(p), U: pressureBaseUnit}
+}
+
+// String returns the Pressure formatted as a ValUnit in pascals
+func (p Pressure) String() string {
+	return p.ValUnit().String()
+}
+
+// PressureFromGigapascals returns the Pressure given a
+// value in units of "GPa"
+func PressureFromGigapascals(val float64) Pressure {
+	return Pressure(val * 1e+09)
+}
+
+// Gigapascals returns the Pressure in units of
+// "GPa"
+func (p Pressure) Gigapascals() float64 {
+	base := float64(p)
+
+	return base / 1e+09
+}
+
+// PressureFromMegapascals returns the Pressure given a
+// value in units of "MPa"
+func PressureFromMegapascals(val float64) Pressure {
+	return Pressure(val * 1e+06)
+}
+
+// Megapascals returns the Pressure in units of
+// "MPa"
+func (p Pressure) Megapascals() float64 {
+	base := float64(p)
+
+	return base / 1e+06
+}
+
+// PressureFromMegapoundsPerSquareInch returns the Pressure given a
+// value in units of "Mpsi"
+func PressureFromMegapoundsPerSquareInch(val float64) Pressure {
+	return Pressure(val * 6.894757293e+09)
+}
+
+// MegapoundsPerSquareInch returns the Pressure in units of
+// "Mpsi"
+func (p Pressure) MegapoundsPerSquareInch() float64 {
+	base := float64(p)
+
+	return base / 6.894757293e+09
+}
+
+// PressureFromPetapascals returns the Pressure given a
+// value in units of "PPa"
+func PressureFromPetapascals(val float64) Pressure {
+	return Pressure(val * 1e+15)
+}
+
+// Petapascals returns the Pressure in units of
+// "PPa"
+func (p Pressure) Petapascals() float64 {
+	base := float64(p)
+
+	return base / 1e+15
+}
+
+// PressureFromTerapascals returns the Pressure given a
+// value in units of "TPa"
+func PressureFromTerapascals(val float64) Pressure {
+	return Pressure(val * 1e+12)
+}
+
+// Terapascals returns the Pressure in units of
+// "TPa"
+func (p Pressure) Terapascals() float64 {
+	base := float64(p)
+
+	return base / 1e+12
+}
+
+// PressureFromTorrs returns the Pressure given a
+// value in units of "Torr"
+func PressureFromTorrs(val float64) Pressure {
+	return Pressure(val * 133.32236842105263)
+}
+
+// Torrs returns the Pressure in units of
+// "Torr"
+func (p Pressure) Torrs() float64 {
+	base := float64(p)
+
+	return base / 133.32236842105263
+}
+
+// PressureFromBars returns the Pressure given a
+// value in units of "bar"
+func PressureFromBars(val float64) Pressure {
+	return Pressure(val * 100000.0)
+}
+
+// Bars returns the Pressure in units of
+// "bar"
+func (p Pressure) Bars() float64 {
+	base := float64(p)
+
+	return base / 100000.0
+}
+
+// PressureFromBaryes returns the Pressure given a
+// value in units of "barye"
+func PressureFromBaryes(val float64) Pressure {
+	return Pressure(val * 0.1)
+}
+
+// Baryes returns the Pressure in units of
+// "barye"
+func (p Pressure) Baryes() float64 {
+	base := float64(p)
+
+	return base / 0.1
+}
+
+// PressureFromCentipascals returns the Pressure given a
+// value in units of "cPa"
+func PressureFromCentipascals(val float64) Pressure {
+	return Pressure(val * 0.01)
+}
+
+// Centipascals returns the Pressure in units of
+// "cPa"
+func (p Pressure) Centipascals() float64 {
+	base := float64(p)
+
+	return base / 0.01
+}
+
+// PressureFromCentibars returns the Pressure given a
+// value in units of "centibar"
+func PressureFromCentibars(val float64) Pressure {
+	return Pressure(val * 1000.0)
+}
+
+// Centibars returns the Pressure in units of
+// "centibar"
+func (p Pressure) Centibars() float64 {
+	base := float64(p)
+
+	return base / 1000.0
+}
+
+// PressureFromDecipascals returns the Pressure given a
+// value in units of "dPa"
+func PressureFromDecipascals(val float64) Pressure {
+	return Pressure(val * 0.1)
+}
+
+// Decipascals returns the Pressure in units of
+// "dPa"
+func (p Pressure) Decipascals() float64 {
+	base := float64(p)
+
+	return base / 0.1
+}
+
+// PressureFromDecibars returns the Pressure given a
+// value in units of "decibar"
+func PressureFromDecibars(val float64) Pressure {
+	return Pressure(val * 10000.0)
+}
+
+// Decibars returns the Pressure in units of
+// "decibar"
+func (p Pressure) Decibars() float64 {
+	base := float64(p)
+
+	return base / 10000.0
+}
+
+// PressureFromHectopascals returns the Pressure given a
+// value in units of "hPa"
+func PressureFromHectopascals(val float64) Pressure {
+	return Pressure(val * 100.0)
+}
+
+// Hectopascals returns the Pressure in units of
+// "hPa"
+func (p Pressure) Hectopascals() float64 {
+	base := float64(p)
+
+	return base / 100.0
+}
+
+// PressureFromKilopascals returns the Pressure given a
+// value in units of "kPa"
+func PressureFromKilopascals(val float64) Pressure {
+	return Pressure(val * 1000.0)
+}
+
+// Kilopascals returns the Pressure in units of
+// "kPa"
+func (p Pressure) Kilopascals() float64 {
+	base := float64(p)
+
+	return base / 1000.0
+}
+
+// PressureFromKilobars returns the Pressure given a
+// value in units of "kilobar"
+func PressureFromKilobars(val float64) Pressure {
+	return Pressure(val * 1e+08)
+}
+
+// Kilobars returns the Pressure in units of
+// "kilobar"
+func (p Pressure) Kilobars() float64 {
+	base := float64(p)
+
+	return base / 1e+08
+}
+
+// PressureFromKilobaryes returns the Pressure given a
+// value in units of "kilobarye"
+func PressureFromKilobaryes(val float64) Pressure {
+	return Pressure(val * 100.0)
+}
+
+// Kilobaryes returns the Pressure in units of
+// "kilobarye"
+func (p Pressure) Kilobaryes() float64 {
+	base := float64(p)
+
+	return base / 100.0
+}
+
+// PressureFromKilopoundsPerSquareInch returns the Pressure given a
+// value in units of "kpsi"
+func PressureFromKilopoundsPerSquareInch(val float64) Pressure {
+	return Pressure(val * 6.894757293e+06)
+}
+
+// KilopoundsPerSquareInch returns the Pressure in units of
+// "kpsi"
+func (p Pressure) KilopoundsPerSquareInch() float64 {
+	base := float64(p)
+
+	return base / 6.894757293e+06
+}
+
+// PressureFromMillipascals returns the Pressure given a
+// value in units of "mPa"
+func PressureFromMillipascals(val float64) Pressure {
+	return Pressure(val * 0.001)
+}
+
+// Millipascals returns the Pressure in units of
+// "mPa"
+func (p Pressure) Millipascals() float64 {
+	base := float64(p)
+
+	return base / 0.001
+}
+
+// PressureFromMegabars returns the Pressure given a
+// value in units of "megabar"
+func PressureFromMegabars(val float64) Pressure {
+	return Pressure(val * 1e+11)
+}
+
+// Megabars returns the Pressure in units of
+// "megabar"
+func (p Pressure) Megabars() float64 {
+	base := float64(p)
+
+	return base / 1e+11
+}
+
+// PressureFromMillibars returns the Pressure given a
+// value in units of "millibar"
+func PressureFromMillibars(val float64) Pressure {
+	return Pressure(val * 100.0)
+}
+
+// Millibars returns the Pressure in units of
+// "millibar"
+func (p Pressure) Millibars() float64 {
+	base := float64(p)
+
+	return base / 100.0
+}
+
+// PressureFromMillibaryes returns the Pressure given a
+// value in units of "millibarye"
+func PressureFromMillibaryes(val float64) Pressure {
+	return Pressure(val * 0.0001)
+}
+
+// Millibaryes returns the Pressure in units of
+// "millibarye"
+func (p Pressure) Millibaryes() float64 {
+	base := float64(p)
+
+	return base / 0.0001
+}
+
+// PressureFromMillimetresOfMercury returns the Pressure given a
+// value in units of "mmHg"
+func PressureFromMillimetresOfMercury(val float64) Pressure {
+	return Pressure(val * 133.322387415)
+}
+
+// MillimetresOfMercury returns the Pressure in units of
+// "mmHg"
+func (p Pressure) MillimetresOfMercury() float64 {
+	base := float64(p)
+
+	return base / 133.322387415
+}
+
+// PressureFromPascals returns the Pressure given a
+// value in units of "pascal"
+func PressureFromPascals(val float64) Pressure {
+	return Pressure(val)
+}
+
+// Pascals returns the Pressure in units of
+// "pascal"
+func (p Pressure) Pascals() float64 {
+	base := float64(p)
+
+	return base
+}
+
+// PressureFromPoundsPerSquareInch returns the Pressure given a
+// value in units of "psi"
+func PressureFromPoundsPerSquareInch(val float64) Pressure {
+	return Pressure(val * 6894.757293)
+}
+
+// PoundsPerSquareInch returns the Pressure in units of
+// "psi"
+func (p Pressure) PoundsPerSquareInch() float64 {
+	base := float64(p)
+
+	return base / 6894.757293
+}
+
+// PressureFromAtmospheres returns the Pressure given a
+// value in units of "standard atmosphere"
+func PressureFromAtmospheres(val float64) Pressure {
+	return Pressure(val * 101325.0)
+}
+
+// Atmospheres returns the Pressure in units of
+// "standard atmosphere"
+func (p Pressure) Atmospheres() float64 {
+	base := float64(p)
+
+	return base / 101325.0
+}
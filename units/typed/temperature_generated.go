@@ -0,0 +1,142 @@
+// Code generated by gentyped; DO NOT EDIT.
+
+package typed
+
+import "github.com/nickwells/units.mod/v2/units"
+
+// Temperature is a temperature held in degrees Celsius, the base units of the
+// temperature Family.
+type Temperature float64
+
+// temperatureBaseUnit is the base unit of the temperature Family
+var temperatureBaseUnit = units.GetOrPanic("temperature", "C")
+
+// TemperatureFromValUnit returns the Temperature having the value of the
+// ValUnit. It returns a non-nil error if the ValUnit is not a temperature.
+func TemperatureFromValUnit(vu units.ValUnit) (Temperature, error) {
+	base, err := vu.Convert(temperatureBaseUnit)
+
+	return Temperature(base.V), err
+}
+
+// ValUnit returns the Temperature as a ValUnit in degrees Celsius
+func (t Temperature) ValUnit() units.ValUnit {
+	return units.ValUnit{V: float64(t), U: temperatureBaseUnit}
+}
+
+// String returns the Temperature formatted as a ValUnit in degrees Celsius
+func (t Temperature) String() string {
+	return t.ValUnit().String()
+}
+
+// TemperatureFromDegreesCelsius returns the Temperature given a
+// value in units of "C"
+func TemperatureFromDegreesCelsius(val float64) Temperature {
+	return Temperature(val)
+}
+
+// DegreesCelsius returns the Temperature in units of
+// "C"
+func (t Temperature) DegreesCelsius() float64 {
+	base := float64(t)
+
+	return base
+}
+
+// TemperatureFromDegreesDelisle returns the Temperature given a
+// value in units of "D"
+func TemperatureFromDegreesDelisle(val float64) Temperature {
+	return Temperature(val*-0.6666666666666666 + 100.0)
+}
+
+// DegreesDelisle returns the Temperature in units of
+// "D"
+func (t Temperature) DegreesDelisle() float64 {
+	base := float64(t)
+
+	return (base - 100.0) / -0.6666666666666666
+}
+
+// TemperatureFromDegreesFahrenheit returns the Temperature given a
+// value in units of "F"
+func TemperatureFromDegreesFahrenheit(val float64) Temperature {
+	return Temperature((val - 32.0) * 0.5555555555555556)
+}
+
+// DegreesFahrenheit returns the Temperature in units of
+// "F"
+func (t Temperature) DegreesFahrenheit() float64 {
+	base := float64(t)
+
+	return base/0.5555555555555556 + 32.0
+}
+
+// TemperatureFromKelvin returns the Temperature given a
+// value in units of "K"
+func TemperatureFromKelvin(val float64) Temperature {
+	return Temperature(val - 273.15)
+}
+
+// Kelvin returns the Temperature in units of
+// "K"
+func (t Temperature) Kelvin() float64 {
+	base := float64(t)
+
+	return base + 273.15
+}
+
+// TemperatureFromDegreesNewton returns the Temperature given a
+// value in units of "N"
+func TemperatureFromDegreesNewton(val float64) Temperature {
+	return Temperature(val * 3.0303030303030303)
+}
+
+// DegreesNewton returns the Temperature in units of
+// "N"
+func (t Temperature) DegreesNewton() float64 {
+	base := float64(t)
+
+	return base / 3.0303030303030303
+}
+
+// TemperatureFromDegreesRankine returns the Temperature given a
+// value in units of "Ra"
+func TemperatureFromDegreesRankine(val float64) Temperature {
+	return Temperature(val*0.5555555555555556 - 273.15)
+}
+
+// DegreesRankine returns the Temperature in units of
+// "Ra"
+func (t Temperature) DegreesRankine() float64 {
+	base := float64(t)
+
+	return (base + 273.15) / 0.5555555555555556
+}
+
+// TemperatureFromDegreesReaumur returns the Temperature given a
+// value in units of "Re"
+func TemperatureFromDegreesReaumur(val float64) Temperature {
+	return Temperature(val * 1.25)
+}
+
+// DegreesReaumur returns the Temperature in units of
+// "Re"
+func (t Temperature) DegreesReaumur() float64 {
+	base := float64(t)
+
+	return base / 1.25
+}
+
+// TemperatureFromDegreesRomer returns the Temperature given a
+// value in units of "Ro"
+func TemperatureFromDegreesRomer(val float64) Temperature {
+	return Temperature((val - 7.5) * 1.9047619047619047)
+}
+
+// DegreesRomer returns the Temperature in units of
+// "Ro"
+func (t Temperature) DegreesRomer() float64 {
+	base := float64(t)
+
+	return base/1.9047619047619047 + 7.5
+}
@@ -0,0 +1,590 @@
+// Code generated by gentyped; DO NOT EDIT.
+
+package typed
+
+import "github.com/nickwells/units.mod/v2/units"
+
+// Time is a time held in seconds, the base units of the
+// time Family.
+type Time float64
+
+// timeBaseUnit is the base unit of the time Family
+var timeBaseUnit = units.GetOrPanic("time", "second")
+
+// TimeFromValUnit returns the Time having the value of the
+// ValUnit. It returns a non-nil error if the ValUnit is not a time.
+func TimeFromValUnit(vu units.ValUnit) (Time, error) {
+	base, err := vu.Convert(timeBaseUnit)
+
+	return Time(base.V), err
+}
+
+// ValUnit returns the Time as a ValUnit in seconds
+func (t Time) ValUnit() units.ValUnit {
+	return units.ValUnit{V: float64(t), U: timeBaseUnit}
+}
+
+// String returns the Time formatted as a ValUnit in seconds
+func (t Time) String() string {
+	return t.ValUnit().String()
+}
+
+// TimeFromExaseconds returns the Time given a
+// value in units of "Esec"
+func TimeFromExaseconds(val float64) Time {
+	return Time(val * 1e+18)
+}
+
+// Exaseconds returns the Time in units of
+// "Esec"
+func (t Time) Exaseconds() float64 {
+	base := float64(t)
+
+	return base / 1e+18
+}
+
+// TimeFromGregorianYears returns the Time given a
+// value in units of "Gregorian year"
+func TimeFromGregorianYears(val float64) Time {
+	return Time(val * 3.1556952e+07)
+}
+
+// GregorianYears returns the Time in units of
+// "Gregorian year"
+func (t Time) GregorianYears() float64 {
+	base := float64(t)
+
+	return base / 3.1556952e+07
+}
+
+// TimeFromGigaseconds returns the Time given a
+// value in units of "Gsec"
+func TimeFromGigaseconds(val float64) Time {
+	return Time(val * 1e+09)
+}
+
+// Gigaseconds returns the Time in units of
+// "Gsec"
+func (t Time) Gigaseconds() float64 {
+	base := float64(t)
+
+	return base / 1e+09
+}
+
+// TimeFromJulianMonths returns the Time given a
+// value in units of "Julian month"
+func TimeFromJulianMonths(val float64) Time {
+	return Time(val * 2.6298e+06)
+}
+
+// JulianMonths returns the Time in units of
+// "Julian month"
+func (t Time) JulianMonths() float64 {
+	base := float64(t)
+
+	return base / 2.6298e+06
+}
+
+// TimeFromJulianYears returns the Time given a
+// value in units of "Julian year"
+func TimeFromJulianYears(val float64) Time {
+	return Time(val * 3.15576e+07)
+}
+
+// JulianYears returns the Time in units of
+// "Julian year"
+func (t Time) JulianYears() float64 {
+	base := float64(t)
+
+	return base / 3.15576e+07
+}
+
+// TimeFromMegaseconds returns the Time given a
+// value in units of "Msec"
+func TimeFromMegaseconds(val float64) Time {
+	return Time(val * 1e+06)
+}
+
+// Megaseconds returns the Time in units of
+// "Msec"
+func (t Time) Megaseconds() float64 {
+	base := float64(t)
+
+	return base / 1e+06
+}
+
+// TimeFromPetaseconds returns the Time given a
+// value in units of "Psec"
+func TimeFromPetaseconds(val float64) Time {
+	return Time(val * 1e+15)
+}
+
+// Petaseconds returns the Time in units of
+// "Psec"
+func (t Time) Petaseconds() float64 {
+	base := float64(t)
+
+	return base / 1e+15
+}
+
+// TimeFromSiderealYears returns the Time given a
+// value in units of "Sidereal year"
+func TimeFromSiderealYears(val float64) Time {
+	return Time(val * 3.15581497635456e+07)
+}
+
+// SiderealYears returns the Time in units of
+// "Sidereal year"
+func (t Time) SiderealYears() float64 {
+	base := float64(t)
+
+	return base / 3.15581497635456e+07
+}
+
+// TimeFromTropicalYears returns the Time given a
+// value in units of "Tropical year"
+func TimeFromTropicalYears(val float64) Time {
+	return Time(val * 3.1556925216e+07)
+}
+
+// TropicalYears returns the Time in units of
+// "Tropical year"
+func (t Time) TropicalYears() float64 {
+	base := float64(t)
+
+	return base / 3.1556925216e+07
+}
+
+// TimeFromTeraseconds returns the Time given a
+// value in units of "Tsec"
+func TimeFromTeraseconds(val float64) Time {
+	return Time(val * 1e+12)
+}
+
+// Teraseconds returns the Time in units of
+// "Tsec"
+func (t Time) Teraseconds() float64 {
+	base := float64(t)
+
+	return base / 1e+12
+}
+
+// TimeFromYottaseconds returns the Time given a
+// value in units of "Ysec"
+func TimeFromYottaseconds(val float64) Time {
+	return Time(val * 1e+24)
+}
+
+// Yottaseconds returns the Time in units of
+// "Ysec"
+func (t Time) Yottaseconds() float64 {
+	base := float64(t)
+
+	return base / 1e+24
+}
+
+// TimeFromZettaseconds returns the Time given a
+// value in units of "Zsec"
+func TimeFromZettaseconds(val float64) Time {
+	return Time(val * 1e+21)
+}
+
+// Zettaseconds returns the Time in units of
+// "Zsec"
+func (t Time) Zettaseconds() float64 {
+	base := float64(t)
+
+	return base / 1e+21
+}
+
+// TimeFromAeons returns the Time given a
+// value in units of "aeon"
+func TimeFromAeons(val float64) Time {
+	return Time(val * 3.1556952e+16)
+}
+
+// Aeons returns the Time in units of
+// "aeon"
+func (t Time) Aeons() float64 {
+	base := float64(t)
+
+	return base / 3.1556952e+16
+}
+
+// TimeFromAttoseconds returns the Time given a
+// value in units of "asec"
+func TimeFromAttoseconds(val float64) Time {
+	return Time(val * 1e-18)
+}
+
+// Attoseconds returns the Time in units of
+// "asec"
+func (t Time) Attoseconds() float64 {
+	base := float64(t)
+
+	return base / 1e-18
+}
+
+// TimeFromCenturies returns the Time given a
+// value in units of "century"
+func TimeFromCenturies(val float64) Time {
+	return Time(val * 3.1556952e+09)
+}
+
+// Centuries returns the Time in units of
+// "century"
+func (t Time) Centuries() float64 {
+	base := float64(t)
+
+	return base / 3.1556952e+09
+}
+
+// TimeFromCentiseconds returns the Time given a
+// value in units of "csec"
+func TimeFromCentiseconds(val float64) Time {
+	return Time(val * 0.01)
+}
+
+// Centiseconds returns the Time in units of
+// "csec"
+func (t Time) Centiseconds() float64 {
+	base := float64(t)
+
+	return base / 0.01
+}
+
+// TimeFromDecaseconds returns the Time given a
+// value in units of "dasec"
+func TimeFromDecaseconds(val float64) Time {
+	return Time(val * 10.0)
+}
+
+// Decaseconds returns the Time in units of
+// "dasec"
+func (t Time) Decaseconds() float64 {
+	base := float64(t)
+
+	return base / 10.0
+}
+
+// TimeFromDays returns the Time given a
+// value in units of "day"
+func TimeFromDays(val float64) Time {
+	return Time(val * 86400.0)
+}
+
+// Days returns the Time in units of
+// "day"
+func (t Time) Days() float64 {
+	base := float64(t)
+
+	return base / 86400.0
+}
+
+// TimeFromDraconicMonths returns the Time given a
+// value in units of "draconic month"
+func TimeFromDraconicMonths(val float64) Time {
+	return Time(val * 2.351135878416e+06)
+}
+
+// DraconicMonths returns the Time in units of
+// "draconic month"
+func (t Time) DraconicMonths() float64 {
+	base := float64(t)
+
+	return base / 2.351135878416e+06
+}
+
+// TimeFromDeciseconds returns the Time given a
+// value in units of "dsec"
+func TimeFromDeciseconds(val float64) Time {
+	return Time(val * 0.1)
+}
+
+// Deciseconds returns the Time in units of
+// "dsec"
+func (t Time) Deciseconds() float64 {
+	base := float64(t)
+
+	return base / 0.1
+}
+
+// TimeFromFortnights returns the Time given a
+// value in units of "fortnight"
+func TimeFromFortnights(val float64) Time {
+	return Time(val * 1.2096e+06)
+}
+
+// Fortnights returns the Time in units of
+// "fortnight"
+func (t Time) Fortnights() float64 {
+	base := float64(t)
+
+	return base / 1.2096e+06
+}
+
+// TimeFromFemtoseconds returns the Time given a
+// value in units of "fsec"
+func TimeFromFemtoseconds(val float64) Time {
+	return Time(val * 1e-15)
+}
+
+// Femtoseconds returns the Time in units of
+// "fsec"
+func (t Time) Femtoseconds() float64 {
+	base := float64(t)
+
+	return base / 1e-15
+}
+
+// TimeFromHours returns the Time given a
+// value in units of "hour"
+func TimeFromHours(val float64) Time {
+	return Time(val * 3600.0)
+}
+
+// Hours returns the Time in units of
+// "hour"
+func (t Time) Hours() float64 {
+	base := float64(t)
+
+	return base / 3600.0
+}
+
+// TimeFromHectoseconds returns the Time given a
+// value in units of "hsec"
+func TimeFromHectoseconds(val float64) Time {
+	return Time(val * 100.0)
+}
+
+// Hectoseconds returns the Time in units of
+// "hsec"
+func (t Time) Hectoseconds() float64 {
+	base := float64(t)
+
+	return base / 100.0
+}
+
+// TimeFromKiloseconds returns the Time given a
+// value in units of "ksec"
+func TimeFromKiloseconds(val float64) Time {
+	return Time(val * 1000.0)
+}
+
+// Kiloseconds returns the Time in units of
+// "ksec"
+func (t Time) Kiloseconds() float64 {
+	base := float64(t)
+
+	return base / 1000.0
+}
+
+// TimeFromLunarMonths returns the Time given a
+// value in units of "lunar month"
+func TimeFromLunarMonths(val float64) Time {
+	return Time(val * 2.4192e+06)
+}
+
+// LunarMonths returns the Time in units of
+// "lunar month"
+func (t Time) LunarMonths() float64 {
+	base := float64(t)
+
+	return base / 2.4192e+06
+}
+
+// TimeFromLunations returns the Time given a
+// value in units of "lunation"
+func TimeFromLunations(val float64) Time {
+	return Time(val * 2.5514428775904e+06)
+}
+
+// Lunations returns the Time in units of
+// "lunation"
+func (t Time) Lunations() float64 {
+	base := float64(t)
+
+	return base / 2.5514428775904e+06
+}
+
+// TimeFromMillennia returns the Time given a
+// value in units of "millennium"
+func TimeFromMillennia(val float64) Time {
+	return Time(val * 3.1556952e+10)
+}
+
+// Millennia returns the Time in units of
+// "millennium"
+func (t Time) Millennia() float64 {
+	base := float64(t)
+
+	return base / 3.1556952e+10
+}
+
+// TimeFromMinutes returns the Time given a
+// value in units of "minute"
+func TimeFromMinutes(val float64) Time {
+	return Time(val * 60.0)
+}
+
+// Minutes returns the Time in units of
+// "minute"
+func (t Time) Minutes() float64 {
+	base := float64(t)
+
+	return base / 60.0
+}
+
+// TimeFromMonths returns the Time given a
+// value in units of "month"
+func TimeFromMonths(val float64) Time {
+	return Time(val * 2.629746e+06)
+}
+
+// Months returns the Time in units of
+// "month"
+func (t Time) Months() float64 {
+	base := float64(t)
+
+	return base / 2.629746e+06
+}
+
+// TimeFromMilliseconds returns the Time given a
+// value in units of "msec"
+func TimeFromMilliseconds(val float64) Time {
+	return Time(val * 0.001)
+}
+
+// Milliseconds returns the Time in units of
+// "msec"
+func (t Time) Milliseconds() float64 {
+	base := float64(t)
+
+	return base / 0.001
+}
+
+// TimeFromNanoseconds returns the Time given a
+// value in units of "nsec"
+func TimeFromNanoseconds(val float64) Time {
+	return Time(val * 1e-09)
+}
+
+// Nanoseconds returns the Time in units of
+// "nsec"
+func (t Time) Nanoseconds() float64 {
+	base := float64(t)
+
+	return base / 1e-09
+}
+
+// TimeFromPicoseconds returns the Time given a
+// value in units of "psec"
+func TimeFromPicoseconds(val float64) Time {
+	return Time(val * 1e-12)
+}
+
+// Picoseconds returns the Time in units of
+// "psec"
+func (t Time) Picoseconds() float64 {
+	base := float64(t)
+
+	return base / 1e-12
+}
+
+// TimeFromQuarters returns the Time given a
+// value in units of "quarter"
+func TimeFromQuarters(val float64) Time {
+	return Time(val * 7.889238e+06)
+}
+
+// Quarters returns the Time in units of
+// "quarter"
+func (t Time) Quarters() float64 {
+	base := float64(t)
+
+	return base / 7.889238e+06
+}
+
+// TimeFromSeconds returns the Time given a
+// value in units of "second"
+func TimeFromSeconds(val float64) Time {
+	return Time(val)
+}
+
+// Seconds returns the Time in units of
+// "second"
+func (t Time) Seconds() float64 {
+	base := float64(t)
+
+	return base
+}
+
+// TimeFromSiderealMonths returns the Time given a
+// value in units of "sidereal month"
+func TimeFromSiderealMonths(val float64) Time {
+	return Time(val * 2.3605915582656e+06)
+}
+
+// SiderealMonths returns the Time in units of
+// "sidereal month"
+func (t Time) SiderealMonths() float64 {
+	base := float64(t)
+
+	return base / 2.3605915582656e+06
+}
+
+// TimeFromMicroseconds returns the Time given a
+// value in units of "usec"
+func TimeFromMicroseconds(val float64) Time {
+	return Time(val * 1e-06)
+}
+
+// Microseconds returns the Time in units of
+// "usec"
+func (t Time) Microseconds() float64 {
+	base := float64(t)
+
+	return base / 1e-06
+}
+
+// TimeFromWeeks returns the Time given a
+// value in units of "week"
+func TimeFromWeeks(val float64) Time {
+	return Time(val * 604800.0)
+}
+
+// Weeks returns the Time in units of
+// "week"
+func (t Time) Weeks() float64 {
+	base := float64(t)
+
+	return base / 604800.0
+}
+
+// TimeFromYoctoseconds returns the Time given a
+// value in units of "ysec"
+func TimeFromYoctoseconds(val float64) Time {
+	return Time(val * 1e-24)
+}
+
+// Yoctoseconds returns the Time in units of
+// "ysec"
+func (t Time) Yoctoseconds() float64 {
+	base := float64(t)
+
+	return base / 1e-24
+}
+
+// TimeFromZeptoseconds returns the Time given a
+// value in units of "zsec"
+func TimeFromZeptoseconds(val float64) Time {
+	return Time(val * 1e-21)
+}
+
+// Zeptoseconds returns the Time in units of
+// "zsec"
+func (t Time) Zeptoseconds() float64 {
+	base := float64(t)
+
+	return base / 1e-21
+}
@@ -0,0 +1,117 @@
+package typed
+
+import (
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+	"github.com/nickwells/units.mod/v2/units"
+)
+
+func TestConversions(t *testing.T) {
+	const epsilon = 1e-9
+
+	testCases := []struct {
+		testhelper.ID
+		got float64
+		exp float64
+	}{
+		{
+			ID:  testhelper.MkID("feet to metres"),
+			got: DistanceFromFeet(3).Metres(),
+			exp: 0.9144,
+		},
+		{
+			ID:  testhelper.MkID("metres to feet"),
+			got: DistanceFromMetres(0.9144).Feet(),
+			exp: 3,
+		},
+		{
+			ID:  testhelper.MkID("clashing plural name"),
+			got: DistanceFromUSSurveyFeet(3937).Metres(),
+			exp: 1200,
+		},
+		{
+			ID:  testhelper.MkID("with offset"),
+			got: TemperatureFromDegreesFahrenheit(212).DegreesCelsius(),
+			exp: 100,
+		},
+		{
+			ID:  testhelper.MkID("with offset, reversed"),
+			got: TemperatureFromKelvin(0).DegreesFahrenheit(),
+			exp: -459.67,
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffFloat(t, tc.IDStr(), "value", tc.got, tc.exp, epsilon)
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	const epsilon = 1e-9
+
+	d := DistanceFromMetres(10)
+	tm := TimeFromSeconds(4)
+
+	testCases := []struct {
+		testhelper.ID
+		got float64
+		exp float64
+	}{
+		{
+			ID:  testhelper.MkID("distance / time"),
+			got: d.DivTime(tm).MetresPerSecond(),
+			exp: 2.5,
+		},
+		{
+			ID:  testhelper.MkID("velocity * time"),
+			got: d.DivTime(tm).MulTime(tm).Metres(),
+			exp: 10,
+		},
+		{
+			ID:  testhelper.MkID("distance * distance"),
+			got: d.MulDistance(d).SquareMetres(),
+			exp: 100,
+		},
+		{
+			ID:  testhelper.MkID("volume / area"),
+			got: d.MulDistance(d).MulDistance(d).DivArea(d.MulDistance(d)).Metres(),
+			exp: 10,
+		},
+		{
+			ID:  testhelper.MkID("pressure * volume"),
+			got: PressureFromPascals(2).MulVolume(VolumeFromCubicMetres(3)).Joules(),
+			exp: 6,
+		},
+		{
+			ID:  testhelper.MkID("same type, native operators"),
+			got: (d + DistanceFromFeet(1)*2).Metres(),
+			exp: 10.6096,
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffFloat(t, tc.IDStr(), "value", tc.got, tc.exp, epsilon)
+	}
+}
+
+func TestValUnit(t *testing.T) {
+	d, err := DistanceFromValUnit(
+		units.ValUnit{V: 2, U: units.GetOrPanic(units.Distance, "km")})
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	testhelper.DiffFloat(t, "2 km", "metres", float64(d), 2000, 0)
+	testhelper.DiffString(t, "2 km", "string", d.String(), "2000 metres")
+
+	_, err = DistanceFromValUnit(
+		units.ValUnit{V: 2, U: units.GetOrPanic(units.Mass, "kg")})
+	testhelper.CheckExpErr(t, err, struct {
+		testhelper.ID
+		testhelper.ExpErr
+	}{
+		ID:     testhelper.MkID("2 kg"),
+		ExpErr: testhelper.MkExpErr("mismatched unit families"),
+	})
+}
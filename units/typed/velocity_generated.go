@@ -0,0 +1,114 @@
+// Code generated by gentyped; DO NOT EDIT.
+
+package typed
+
+import "github.com/nickwells/units.mod/v2/units"
+
+// Velocity is a velocity held in metres/second, the base units of the
+// velocity Family.
+type Velocity float64
+
+// velocityBaseUnit is the base unit of the velocity Family
+var velocityBaseUnit = units.GetOrPanic("velocity", "metre/second")
+
+// VelocityFromValUnit returns the Velocity having the value of the
+// ValUnit. It returns a non-nil error if the ValUnit is not a velocity.
+func VelocityFromValUnit(vu units.ValUnit) (Velocity, error) {
+	base, err := vu.Convert(velocityBaseUnit)
+
+	return Velocity(base.V), err
+}
+
+// ValUnit returns the Velocity as a ValUnit in metres/second
+func (v Velocity) ValUnit() units.ValUnit {
+	return units.ValUnit{V: float64(v), U: velocityBaseUnit}
+}
+
+// String returns the Velocity formatted as a ValUnit in metres/second
+func (v Velocity) String() string {
+	return v.ValUnit().String()
+}
+
+// VelocityFromFeetPerSec returns the Velocity given a
+// value in units of "foot/second"
+func VelocityFromFeetPerSec(val float64) Velocity {
+	return Velocity(val * 0.3048)
+}
+
+// FeetPerSec returns the Velocity in units of
+// "foot/second"
+func (v Velocity) FeetPerSec() float64 {
+	base := float64(v)
+
+	return base / 0.3048
+}
+
+// VelocityFromKilometresPerHour returns the Velocity given a
+// value in units of "kilometre/hour"
+func VelocityFromKilometresPerHour(val float64) Velocity {
+	return Velocity(val * 0.2777777777777778)
+}
+
+// KilometresPerHour returns the Velocity in units of
+// "kilometre/hour"
+func (v Velocity) KilometresPerHour() float64 {
+	base := float64(v)
+
+	return base / 0.2777777777777778
+}
+
+// VelocityFromKnots returns the Velocity given a
+// value in units of "knot"
+func VelocityFromKnots(val float64) Velocity {
+	return Velocity(val * 0.5144444444444445)
+}
+
+// Knots returns the Velocity in units of
+// "knot"
+func (v Velocity) Knots() float64 {
+	base := float64(v)
+
+	return base / 0.5144444444444445
+}
+
+// VelocityFromMetresPerSecond returns the Velocity given a
+// value in units of "metre/second"
+func VelocityFromMetresPerSecond(val float64) Velocity {
+	return Velocity(val)
+}
+
+// MetresPerSecond returns the Velocity in units of
+// "metre/second"
+func (v Velocity) MetresPerSecond() float64 {
+	base := float64(v)
+
+	return base
+}
+
+// VelocityFromMilesPerHour returns the Velocity given a
+// value in units of "mile/hour"
+func VelocityFromMilesPerHour(val float64) Velocity {
+	return Velocity(val * 0.44704)
+}
+
+// MilesPerHour returns the Velocity in units of
+// "mile/hour"
+func (v Velocity) MilesPerHour() float64 {
+	base := float64(v)
+
+	return base / 0.44704
+}
+
+// VelocityFromPercentOfTheSpeedOfLight returns the Velocity given a
+// value in units of "percentOfSpeedOfLight"
+func VelocityFromPercentOfTheSpeedOfLight(val float64) Velocity {
+	return Velocity(val * 2.99792458e+06)
+}
+
+// PercentOfTheSpeedOfLight returns the Velocity in units of
+// "percentOfSpeedOfLight"
+func (v Velocity) PercentOfTheSpeedOfLight() float64 {
+	base := float64(v)
+
+	return base / 2.99792458e+06
+}
@@ -0,0 +1,1038 @@
+// Code generated by gentyped; DO NOT EDIT.
+
+package typed
+
+import "github.com/nickwells/units.mod/v2/units"
+
+// Volume is a volume held in cubic metres, the base units of the
+// volume Family.
+type Volume float64
+
+// volumeBaseUnit is the base unit of the volume Family
+var volumeBaseUnit = units.GetOrPanic("volume", "cubic metre")
+
+// VolumeFromValUnit returns the Volume having the value of the
+// ValUnit. It returns a non-nil error if the ValUnit is not a volume.
+func VolumeFromValUnit(vu units.ValUnit) (Volume, error) {
+	base, err := vu.Convert(volumeBaseUnit)
+
+	return Volume(base.V), err
+}
+
+// ValUnit returns the Volume as a ValUnit in cubic metres
+func (v Volume) ValUnit() units.ValUnit {
+	return units.ValUnit{V: float64(v), U: volumeBaseUnit}
+}
+
+// String returns the Volume formatted as a ValUnit in cubic metres
+func (v Volume) String() string {
+	return v.ValUnit().String()
+}
+
+// VolumeFromVolumesOfA20ftShippingContainer returns the Volume given a
+// value in units of "20ft shipping container"
+func VolumeFromVolumesOfA20ftShippingContainer(val float64) Volume {
+	return Volume(val * 38.2841368)
+}
+
+// VolumesOfA20ftShippingContainer returns the Volume in units of
+// "20ft shipping container"
+func (v Volume) VolumesOfA20ftShippingContainer() float64 {
+	base := float64(v)
+
+	return base / 38.2841368
+}
+
+// VolumeFromAustralianTablespoons returns the Volume given a
+// value in units of "Australian tablespoon"
+func VolumeFromAustralianTablespoons(val float64) Volume {
+	return Volume(val * 2e-05)
+}
+
+// AustralianTablespoons returns the Volume in units of
+// "Australian tablespoon"
+func (v Volume) AustralianTablespoons() float64 {
+	base := float64(v)
+
+	return base / 2e-05
+}
+
+// VolumeFromExalitres returns the Volume given a
+// value in units of "El"
+func VolumeFromExalitres(val float64) Volume {
+	return Volume(val * 1e+15)
+}
+
+// Exalitres returns the Volume in units of
+// "El"
+func (v Volume) Exalitres() float64 {
+	base := float64(v)
+
+	return base / 1e+15
+}
+
+// VolumeFromBillionsOfBarrelsOfOil returns the Volume given a
+// value in units of "Gbbl"
+func VolumeFromBillionsOfBarrelsOfOil(val float64) Volume {
+	return Volume(val * 1.58987294928e+08)
+}
+
+// BillionsOfBarrelsOfOil returns the Volume in units of
+// "Gbbl"
+func (v Volume) BillionsOfBarrelsOfOil() float64 {
+	base := float64(v)
+
+	return base / 1.58987294928e+08
+}
+
+// VolumeFromGigalitres returns the Volume given a
+// value in units of "Gl"
+func VolumeFromGigalitres(val float64) Volume {
+	return Volume(val * 1e+06)
+}
+
+// Gigalitres returns the Volume in units of
+// "Gl"
+func (v Volume) Gigalitres() float64 {
+	base := float64(v)
+
+	return base / 1e+06
+}
+
+// VolumeFromMillionsOfBarrelsOfOil returns the Volume given a
+// value in units of "MMbbl"
+func VolumeFromMillionsOfBarrelsOfOil(val float64) Volume {
+	return Volume(val * 158987.294928)
+}
+
+// MillionsOfBarrelsOfOil returns the Volume in units of
+// "MMbbl"
+func (v Volume) MillionsOfBarrelsOfOil() float64 {
+	base := float64(v)
+
+	return base / 158987.294928
+}
+
+// VolumeFromThousandsOfBarrelsOfOil returns the Volume given a
+// value in units of "Mbbl"
+func VolumeFromThousandsOfBarrelsOfOil(val float64) Volume {
+	return Volume(val * 158.987294928)
+}
+
+// ThousandsOfBarrelsOfOil returns the Volume in units of
+// "Mbbl"
+func (v Volume) ThousandsOfBarrelsOfOil() float64 {
+	base := float64(v)
+
+	return base / 158.987294928
+}
+
+// VolumeFromMegalitres returns the Volume given a
+// value in units of "Ml"
+func VolumeFromMegalitres(val float64) Volume {
+	return Volume(val * 1000.0)
+}
+
+// Megalitres returns the Volume in units of
+// "Ml"
+func (v Volume) Megalitres() float64 {
+	base := float64(v)
+
+	return base / 1000.0
+}
+
+// VolumeFromPetalitres returns the Volume given a
+// value in units of "Pl"
+func VolumeFromPetalitres(val float64) Volume {
+	return Volume(val * 1e+12)
+}
+
+// Petalitres returns the Volume in units of
+// "Pl"
+func (v Volume) Petalitres() float64 {
+	base := float64(v)
+
+	return base / 1e+12
+}
+
+// VolumeFromTeralitres returns the Volume given a
+// value in units of "Tl"
+func VolumeFromTeralitres(val float64) Volume {
+	return Volume(val * 1e+09)
+}
+
+// Teralitres returns the Volume in units of
+// "Tl"
+func (v Volume) Teralitres() float64 {
+	base := float64(v)
+
+	return base / 1e+09
+}
+
+// VolumeFromUSTablespoons returns the Volume given a
+// value in units of "US tablespoon"
+func VolumeFromUSTablespoons(val float64) Volume {
+	return Volume(val * 1.478676478125e-05)
+}
+
+// USTablespoons returns the Volume in units of
+// "US tablespoon"
+func (v Volume) USTablespoons() float64 {
+	base := float64(v)
+
+	return base / 1.478676478125e-05
+}
+
+// VolumeFromUSTeaspoons returns the Volume given a
+// value in units of "US teaspoon"
+func VolumeFromUSTeaspoons(val float64) Volume {
+	return Volume(val * 4.92892159375e-06)
+}
+
+// USTeaspoons returns the Volume in units of
+// "US teaspoon"
+func (v Volume) USTeaspoons() float64 {
+	base := float64(v)
+
+	return base / 4.92892159375e-06
+}
+
+// VolumeFromUSBushels returns the Volume given a
+// value in units of "US-bushel"
+func VolumeFromUSBushels(val float64) Volume {
+	return Volume(val * 0.03523907016688)
+}
+
+// USBushels returns the Volume in units of
+// "US-bushel"
+func (v Volume) USBushels() float64 {
+	base := float64(v)
+
+	return base / 0.03523907016688
+}
+
+// VolumeFromUSCups returns the Volume given a
+// value in units of "US-cup"
+func VolumeFromUSCups(val float64) Volume {
+	return Volume(val * 0.0002365882365)
+}
+
+// USCups returns the Volume in units of
+// "US-cup"
+func (v Volume) USCups() float64 {
+	base := float64(v)
+
+	return base / 0.0002365882365
+}
+
+// VolumeFromUSDryGallons returns the Volume given a
+// value in units of "US-dry-gallon"
+func VolumeFromUSDryGallons(val float64) Volume {
+	return Volume(val * 0.00440488377086)
+}
+
+// USDryGallons returns the Volume in units of
+// "US-dry-gallon"
+func (v Volume) USDryGallons() float64 {
+	base := float64(v)
+
+	return base / 0.00440488377086
+}
+
+// VolumeFromUSDryPints returns the Volume given a
+// value in units of "US-dry-pint"
+func VolumeFromUSDryPints(val float64) Volume {
+	return Volume(val * 0.0005506104713575)
+}
+
+// USDryPints returns the Volume in units of
+// "US-dry-pint"
+func (v Volume) USDryPints() float64 {
+	base := float64(v)
+
+	return base / 0.0005506104713575
+}
+
+// VolumeFromUSFluidOunces returns the Volume given a
+// value in units of "US-fluid-ounce"
+func VolumeFromUSFluidOunces(val float64) Volume {
+	return Volume(val * 2.95735295625e-05)
+}
+
+// USFluidOunces returns the Volume in units of
+// "US-fluid-ounce"
+func (v Volume) USFluidOunces() float64 {
+	base := float64(v)
+
+	return base / 2.95735295625e-05
+}
+
+// VolumeFromUSGallons returns the Volume given a
+// value in units of "US-gallon"
+func VolumeFromUSGallons(val float64) Volume {
+	return Volume(val * 0.003785411784)
+}
+
+// USGallons returns the Volume in units of
+// "US-gallon"
+func (v Volume) USGallons() float64 {
+	base := float64(v)
+
+	return base / 0.003785411784
+}
+
+// VolumeFromUSGills returns the Volume given a
+// value in units of "US-gill"
+func VolumeFromUSGills(val float64) Volume {
+	return Volume(val * 0.00011829411825)
+}
+
+// USGills returns the Volume in units of
+// "US-gill"
+func (v Volume) USGills() float64 {
+	base := float64(v)
+
+	return base / 0.00011829411825
+}
+
+// VolumeFromUSPints returns the Volume given a
+// value in units of "US-pint"
+func VolumeFromUSPints(val float64) Volume {
+	return Volume(val * 0.000473176473)
+}
+
+// USPints returns the Volume in units of
+// "US-pint"
+func (v Volume) USPints() float64 {
+	base := float64(v)
+
+	return base / 0.000473176473
+}
+
+// VolumeFromUSQuarts returns the Volume given a
+// value in units of "US-quart"
+func VolumeFromUSQuarts(val float64) Volume {
+	return Volume(val * 0.000946352946)
+}
+
+// USQuarts returns the Volume in units of
+// "US-quart"
+func (v Volume) USQuarts() float64 {
+	base := float64(v)
+
+	return base / 0.000946352946
+}
+
+// VolumeFromUSShots returns the Volume given a
+// value in units of "US-shot"
+func VolumeFromUSShots(val float64) Volume {
+	return Volume(val * 4.436029434375e-05)
+}
+
+// USShots returns the Volume in units of
+// "US-shot"
+func (v Volume) USShots() float64 {
+	base := float64(v)
+
+	return base / 4.436029434375e-05
+}
+
+// VolumeFromYottalitres returns the Volume given a
+// value in units of "Yl"
+func VolumeFromYottalitres(val float64) Volume {
+	return Volume(val * 1e+21)
+}
+
+// Yottalitres returns the Volume in units of
+// "Yl"
+func (v Volume) Yottalitres() float64 {
+	base := float64(v)
+
+	return base / 1e+21
+}
+
+// VolumeFromZettalitres returns the Volume given a
+// value in units of "Zl"
+func VolumeFromZettalitres(val float64) Volume {
+	return Volume(val * 1e+18)
+}
+
+// Zettalitres returns the Volume in units of
+// "Zl"
+func (v Volume) Zettalitres() float64 {
+	base := float64(v)
+
+	return base / 1e+18
+}
+
+// VolumeFromAttolitres returns the Volume given a
+// value in units of "al"
+func VolumeFromAttolitres(val float64) Volume {
+	return Volume(val * 1e-21)
+}
+
+// Attolitres returns the Volume in units of
+// "al"
+func (v Volume) Attolitres() float64 {
+	base := float64(v)
+
+	return base / 1e-21
+}
+
+// VolumeFromAnkers returns the Volume given a
+// value in units of "anker"
+func VolumeFromAnkers(val float64) Volume {
+	return Volume(val * 0.03785411784)
+}
+
+// Ankers returns the Volume in units of
+// "anker"
+func (v Volume) Ankers() float64 {
+	base := float64(v)
+
+	return base / 0.03785411784
+}
+
+// VolumeFromBalthazarsWine returns the Volume given a
+// value in units of "balthazar"
+func VolumeFromBalthazarsWine(val float64) Volume {
+	return Volume(val * 0.012)
+}
+
+// BalthazarsWine returns the Volume in units of
+// "balthazar"
+func (v Volume) BalthazarsWine() float64 {
+	base := float64(v)
+
+	return base / 0.012
+}
+
+// VolumeFromBarrels returns the Volume given a
+// value in units of "barrel"
+func VolumeFromBarrels(val float64) Volume {
+	return Volume(val * 0.16365924)
+}
+
+// Barrels returns the Volume in units of
+// "barrel"
+func (v Volume) Barrels() float64 {
+	base := float64(v)
+
+	return base / 0.16365924
+}
+
+// VolumeFromBarrelsOfOil returns the Volume given a
+// value in units of "bbl"
+func VolumeFromBarrelsOfOil(val float64) Volume {
+	return Volume(val * 0.158987294928)
+}
+
+// BarrelsOfOil returns the Volume in units of
+// "bbl"
+func (v Volume) BarrelsOfOil() float64 {
+	base := float64(v)
+
+	return base / 0.158987294928
+}
+
+// VolumeFromBottlesWine returns the Volume given a
+// value in units of "bottle-wine"
+func VolumeFromBottlesWine(val float64) Volume {
+	return Volume(val * 0.00075)
+}
+
+// BottlesWine returns the Volume in units of
+// "bottle-wine"
+func (v Volume) BottlesWine() float64 {
+	base := float64(v)
+
+	return base / 0.00075
+}
+
+// VolumeFromBushels returns the Volume given a
+// value in units of "bushel"
+func VolumeFromBushels(val float64) Volume {
+	return Volume(val * 0.03636872)
+}
+
+// Bushels returns the Volume in units of
+// "bushel"
+func (v Volume) Bushels() float64 {
+	base := float64(v)
+
+	return base / 0.03636872
+}
+
+// VolumeFromCentilitres returns the Volume given a
+// value in units of "cl"
+func VolumeFromCentilitres(val float64) Volume {
+	return Volume(val * 1e-05)
+}
+
+// Centilitres returns the Volume in units of
+// "cl"
+func (v Volume) Centilitres() float64 {
+	base := float64(v)
+
+	return base / 1e-05
+}
+
+// VolumeFromCubicFeet returns the Volume given a
+// value in units of "cubic foot"
+func VolumeFromCubicFeet(val float64) Volume {
+	return Volume(val * 0.028316846592)
+}
+
+// CubicFeet returns the Volume in units of
+// "cubic foot"
+func (v Volume) CubicFeet() float64 {
+	base := float64(v)
+
+	return base / 0.028316846592
+}
+
+// VolumeFromCubicInches returns the Volume given a
+// value in units of "cubic inch"
+func VolumeFromCubicInches(val float64) Volume {
+	return Volume(val * 1.6387064e-05)
+}
+
+// CubicInches returns the Volume in units of
+// "cubic inch"
+func (v Volume) CubicInches() float64 {
+	base := float64(v)
+
+	return base / 1.6387064e-05
+}
+
+// VolumeFromCubicMetres returns the Volume given a
+// value in units of "cubic metre"
+func VolumeFromCubicMetres(val float64) Volume {
+	return Volume(val)
+}
+
+// CubicMetres returns the Volume in units of
+// "cubic metre"
+func (v Volume) CubicMetres() float64 {
+	base := float64(v)
+
+	return base
+}
+
+// VolumeFromCubicYards returns the Volume given a
+// value in units of "cubic yard"
+func VolumeFromCubicYards(val float64) Volume {
+	return Volume(val * 0.764554857984)
+}
+
+// CubicYards returns the Volume in units of
+// "cubic yard"
+func (v Volume) CubicYards() float64 {
+	base := float64(v)
+
+	return base / 0.764554857984
+}
+
+// VolumeFromDecalitres returns the Volume given a
+// value in units of "dal"
+func VolumeFromDecalitres(val float64) Volume {
+	return Volume(val * 0.01)
+}
+
+// Decalitres returns the Volume in units of
+// "dal"
+func (v Volume) Decalitres() float64 {
+	base := float64(v)
+
+	return base / 0.01
+}
+
+// VolumeFromDecilitres returns the Volume given a
+// value in units of "dl"
+func VolumeFromDecilitres(val float64) Volume {
+	return Volume(val * 0.0001)
+}
+
+// Decilitres returns the Volume in units of
+// "dl"
+func (v Volume) Decilitres() float64 {
+	base := float64(v)
+
+	return base / 0.0001
+}
+
+// VolumeFromFirkins returns the Volume given a
+// value in units of "firkin"
+func VolumeFromFirkins(val float64) Volume {
+	return Volume(val * 0.04091481)
+}
+
+// Firkins returns the Volume in units of
+// "firkin"
+func (v Volume) Firkins() float64 {
+	base := float64(v)
+
+	return base / 0.04091481
+}
+
+// VolumeFromFemtolitres returns the Volume given a
+// value in units of "fl"
+func VolumeFromFemtolitres(val float64) Volume {
+	return Volume(val * 1e-18)
+}
+
+// Femtolitres returns the Volume in units of
+// "fl"
+func (v Volume) Femtolitres() float64 {
+	base := float64(v)
+
+	return base / 1e-18
+}
+
+// VolumeFromFluidDrachms returns the Volume given a
+// value in units of "fluid-drachm"
+func VolumeFromFluidDrachms(val float64) Volume {
+	return Volume(val * 3.5516328125e-06)
+}
+
+// FluidDrachms returns the Volume in units of
+// "fluid-drachm"
+func (v Volume) FluidDrachms() float64 {
+	base := float64(v)
+
+	return base / 3.5516328125e-06
+}
+
+// VolumeFromFluidOunces returns the Volume given a
+// value in units of "fluid-ounce"
+func VolumeFromFluidOunces(val float64) Volume {
+	return Volume(val * 2.84130625e-05)
+}
+
+// FluidOunces returns the Volume in units of
+// "fluid-ounce"
+func (v Volume) FluidOunces() float64 {
+	base := float64(v)
+
+	return base / 2.84130625e-05
+}
+
+// VolumeFromFluidScruples returns the Volume given a
+// value in units of "fluid-scruple"
+func VolumeFromFluidScruples(val float64) Volume {
+	return Volume(val * 1.1838776041666667e-06)
+}
+
+// FluidScruples returns the Volume in units of
+// "fluid-scruple"
+func (v Volume) FluidScruples() float64 {
+	base := float64(v)
+
+	return base / 1.1838776041666667e-06
+}
+
+// VolumeFromGallons returns the Volume given a
+// value in units of "gallon"
+func VolumeFromGallons(val float64) Volume {
+	return Volume(val * 0.00454609)
+}
+
+// Gallons returns the Volume in units of
+// "gallon"
+func (v Volume) Gallons() float64 {
+	base := float64(v)
+
+	return base / 0.00454609
+}
+
+// VolumeFromGills returns the Volume given a
+// value in units of "gill"
+func VolumeFromGills(val float64) Volume {
+	return Volume(val * 0.0001420653125)
+}
+
+// Gills returns the Volume in units of
+// "gill"
+func (v Volume) Gills() float64 {
+	base := float64(v)
+
+	return base / 0.0001420653125
+}
+
+// VolumeFromHectolitres returns the Volume given a
+// value in units of "hl"
+func VolumeFromHectolitres(val float64) Volume {
+	return Volume(val * 0.1)
+}
+
+// Hectolitres returns the Volume in units of
+// "hl"
+func (v Volume) Hectolitres() float64 {
+	base := float64(v)
+
+	return base / 0.1
+}
+
+// VolumeFromHogsheads returns the Volume given a
+// value in units of "hogshead"
+func VolumeFromHogsheads(val float64) Volume {
+	return Volume(val * 0.24548886)
+}
+
+// Hogsheads returns the Volume in units of
+// "hogshead"
+func (v Volume) Hogsheads() float64 {
+	base := float64(v)
+
+	return base / 0.24548886
+}
+
+// VolumeFromJeroboamsWine returns the Volume given a
+// value in units of "jeroboam"
+func VolumeFromJeroboamsWine(val float64) Volume {
+	return Volume(val * 0.003)
+}
+
+// JeroboamsWine returns the Volume in units of
+// "jeroboam"
+func (v Volume) JeroboamsWine() float64 {
+	base := float64(v)
+
+	return base / 0.003
+}
+
+// VolumeFromKilderkins returns the Volume given a
+// value in units of "kilderkin"
+func VolumeFromKilderkins(val float64) Volume {
+	return Volume(val * 0.08182962)
+}
+
+// Kilderkins returns the Volume in units of
+// "kilderkin"
+func (v Volume) Kilderkins() float64 {
+	base := float64(v)
+
+	return base / 0.08182962
+}
+
+// VolumeFromKilolitres returns the Volume given a
+// value in units of "kl"
+func VolumeFromKilolitres(val float64) Volume {
+	return Volume(val)
+}
+
+// Kilolitres returns the Volume in units of
+// "kl"
+func (v Volume) Kilolitres() float64 {
+	base := float64(v)
+
+	return base
+}
+
+// VolumeFromLitres returns the Volume given a
+// value in units of "litre"
+func VolumeFromLitres(val float64) Volume {
+	return Volume(val * 0.001)
+}
+
+// Litres returns the Volume in units of
+// "litre"
+func (v Volume) Litres() float64 {
+	base := float64(v)
+
+	return base / 0.001
+}
+
+// VolumeFromMagnumsWine returns the Volume given a
+// value in units of "magnum"
+func VolumeFromMagnumsWine(val float64) Volume {
+	return Volume(val * 0.0015)
+}
+
+// MagnumsWine returns the Volume in units of
+// "magnum"
+func (v Volume) MagnumsWine() float64 {
+	base := float64(v)
+
+	return base / 0.0015
+}
+
+// VolumeFromMarieJeannesWine returns the Volume given a
+// value in units of "marie-jeanne"
+func VolumeFromMarieJeannesWine(val float64) Volume {
+	return Volume(val * 0.00225)
+}
+
+// MarieJeannesWine returns the Volume in units of
+// "marie-jeanne"
+func (v Volume) MarieJeannesWine() float64 {
+	base := float64(v)
+
+	return base / 0.00225
+}
+
+// VolumeFromMethuselahsWine returns the Volume given a
+// value in units of "methuselah"
+func VolumeFromMethuselahsWine(val float64) Volume {
+	return Volume(val * 0.006)
+}
+
+// MethuselahsWine returns the Volume in units of
+// "methuselah"
+func (v Volume) MethuselahsWine() float64 {
+	base := float64(v)
+
+	return base / 0.006
+}
+
+// VolumeFromMinims returns the Volume given a
+// value in units of "minim"
+func VolumeFromMinims(val float64) Volume {
+	return Volume(val * 5.9193880208333334e-08)
+}
+
+// Minims returns the Volume in units of
+// "minim"
+func (v Volume) Minims() float64 {
+	base := float64(v)
+
+	return base / 5.9193880208333334e-08
+}
+
+// VolumeFromMillilitres returns the Volume given a
+// value in units of "ml"
+func VolumeFromMillilitres(val float64) Volume {
+	return Volume(val * 1e-06)
+}
+
+// Millilitres returns the Volume in units of
+// "ml"
+func (v Volume) Millilitres() float64 {
+	base := float64(v)
+
+	return base / 1e-06
+}
+
+// VolumeFromNebuchadnezzarsWine returns the Volume given a
+// value in units of "nebuchadnezzar"
+func VolumeFromNebuchadnezzarsWine(val float64) Volume {
+	return Volume(val * 0.015)
+}
+
+// NebuchadnezzarsWine returns the Volume in units of
+// "nebuchadnezzar"
+func (v Volume) NebuchadnezzarsWine() float64 {
+	base := float64(v)
+
+	return base / 0.015
+}
+
+// VolumeFromNanolitres returns the Volume given a
+// value in units of "nl"
+func VolumeFromNanolitres(val float64) Volume {
+	return Volume(val * 1e-12)
+}
+
+// Nanolitres returns the Volume in units of
+// "nl"
+func (v Volume) Nanolitres() float64 {
+	base := float64(v)
+
+	return base / 1e-12
+}
+
+// VolumeFromPecks returns the Volume given a
+// value in units of "peck"
+func VolumeFromPecks(val float64) Volume {
+	return Volume(val * 0.00909218)
+}
+
+// Pecks returns the Volume in units of
+// "peck"
+func (v Volume) Pecks() float64 {
+	base := float64(v)
+
+	return base / 0.00909218
+}
+
+// VolumeFromMasonryPerches returns the Volume given a
+// value in units of "perch (masonry)"
+func VolumeFromMasonryPerches(val float64) Volume {
+	return Volume(val * 0.700841953152)
+}
+
+// MasonryPerches returns the Volume in units of
+// "perch (masonry)"
+func (v Volume) MasonryPerches() float64 {
+	base := float64(v)
+
+	return base / 0.700841953152
+}
+
+// VolumeFromPins returns the Volume given a
+// value in units of "pin"
+func VolumeFromPins(val float64) Volume {
+	return Volume(val * 0.020457405)
+}
+
+// Pins returns the Volume in units of
+// "pin"
+func (v Volume) Pins() float64 {
+	base := float64(v)
+
+	return base / 0.020457405
+}
+
+// VolumeFromPints returns the Volume given a
+// value in units of "pint"
+func VolumeFromPints(val float64) Volume {
+	return Volume(val * 0.00056826125)
+}
+
+// Pints returns the Volume in units of
+// "pint"
+func (v Volume) Pints() float64 {
+	base := float64(v)
+
+	return base / 0.00056826125
+}
+
+// VolumeFromPicolitres returns the Volume given a
+// value in units of "pl"
+func VolumeFromPicolitres(val float64) Volume {
+	return Volume(val * 1e-15)
+}
+
+// Picolitres returns the Volume in units of
+// "pl"
+func (v Volume) Picolitres() float64 {
+	base := float64(v)
+
+	return base / 1e-15
+}
+
+// VolumeFromQuarts returns the Volume given a
+// value in units of "quart"
+func VolumeFromQuarts(val float64) Volume {
+	return Volume(val * 0.0011365225)
+}
+
+// Quarts returns the Volume in units of
+// "quart"
+func (v Volume) Quarts() float64 {
+	base := float64(v)
+
+	return base / 0.0011365225
+}
+
+// VolumeFromRehoboamsWine returns the Volume given a
+// value in units of "rehoboam"
+func VolumeFromRehoboamsWine(val float64) Volume {
+	return Volume(val * 0.0045)
+}
+
+// RehoboamsWine returns the Volume in units of
+// "rehoboam"
+func (v Volume) RehoboamsWine() float64 {
+	base := float64(v)
+
+	return base / 0.0045
+}
+
+// VolumeFromSalmanazarsWine returns the Volume given a
+// value in units of "salmanazar"
+func VolumeFromSalmanazarsWine(val float64) Volume {
+	return Volume(val * 0.009)
+}
+
+// SalmanazarsWine returns the Volume in units of
+// "salmanazar"
+func (v Volume) SalmanazarsWine() float64 {
+	base := float64(v)
+
+	return base / 0.009
+}
+
+// VolumeFromTablespoons returns the Volume given a
+// value in units of "tablespoon"
+func VolumeFromTablespoons(val float64) Volume {
+	return Volume(val * 1.5e-05)
+}
+
+// Tablespoons returns the Volume in units of
+// "tablespoon"
+func (v Volume) Tablespoons() float64 {
+	base := float64(v)
+
+	return base / 1.5e-05
+}
+
+// VolumeFromTeaspoons returns the Volume given a
+// value in units of "teaspoon"
+func VolumeFromTeaspoons(val float64) Volume {
+	return Volume(val * 5e-06)
+}
+
+// Teaspoons returns the Volume in units of
+// "teaspoon"
+func (v Volume) Teaspoons() float64 {
+	base := float64(v)
+
+	return base / 5e-06
+}
+
+// VolumeFromMicrolitres returns the Volume given a
+// value in units of "ul"
+func VolumeFromMicrolitres(val float64) Volume {
+	return Volume(val * 1e-09)
+}
+
+// Microlitres returns the Volume in units of
+// "ul"
+func (v Volume) Microlitres() float64 {
+	base := float64(v)
+
+	return base / 1e-09
+}
+
+// VolumeFromWineGallons returns the Volume given a
+// value in units of "wine-gallon"
+func VolumeFromWineGallons(val float64) Volume {
+	return Volume(val * 0.003785411784)
+}
+
+// WineGallons returns the Volume in units of
+// "wine-gallon"
+func (v Volume) WineGallons() float64 {
+	base := float64(v)
+
+	return base / 0.003785411784
+}
+
+// VolumeFromYoctolitres returns the Volume given a
+// value in units of "yl"
+func VolumeFromYoctolitres(val float64) Volume {
+	return Volume(val * 1e-27)
+}
+
+// Yoctolitres returns the Volume in units of
+// "yl"
+func (v Volume) Yoctolitres() float64 {
+	base := float64(v)
+
+	return base / 1e-27
+}
+
+// VolumeFromZeptolitres returns the Volume given a
+// value in units of "zl"
+func VolumeFromZeptolitres(val float64) Volume {
+	return Volume(val * 1e-24)
+}
+
+// Zeptolitres returns the Volume in units of
+// "zl"
+func (v Volume) Zeptolitres() float64 {
+	base := float64(v)
+
+	return base / 1e-24
+}
@@ -0,0 +1,351 @@
+package units
+
+import (
+	"html"
+	"strconv"
+	"strings"
+)
+
+// Typography describes the form of output produced by a Formatter. The
+// typographic forms (all but TypoPlain) show the unit by its symbol (see
+// Unit.Symbol) rather than its name or abbreviation.
+type Typography int
+
+// These are the available typographic forms. The examples show how a
+// velocity of -12.5 metres per second would be shown.
+const (
+	// TypoPlain gives plain text: -12.5 metres/second
+	TypoPlain Typography = iota
+	// TypoUnicode gives Unicode text with a proper minus sign, superscripts
+	// and a narrow no-break space between the number and the unit, as
+	// recommended by the SI Brochure: −12.5 m·s⁻¹
+	TypoUnicode
+	// TypoLaTeX gives LaTeX using the siunitx package, with the unit given
+	// as siunitx macros where possible: \qty{-12.5}{\metre\per\second}
+	TypoLaTeX
+	// TypoHTML gives HTML using the sup element for superscripts:
+	// −12.5&#8239;m·s<sup>−1</sup>
+	TypoHTML
+	// TypoMathML gives a MathML math element:
+	// <math ...><mo>−</mo><mn>12.5</mn><mspace .../><mi ...>m</mi>...</math>
+	TypoMathML
+)
+
+// typographyNames maps the Typography values to a descriptive name
+var typographyNames = map[Typography]string{
+	TypoPlain:   "plain",
+	TypoUnicode: "Unicode",
+	TypoLaTeX:   "LaTeX",
+	TypoHTML:    "HTML",
+	TypoMathML:  "MathML",
+}
+
+// String returns the name of the Typography
+func (t Typography) String() string {
+	if s, ok := typographyNames[t]; ok {
+		return s
+	}
+
+	return "Typography(" + strconv.Itoa(int(t)) + ")"
+}
+
+// FmtOptTypography returns a FormatterOpt which sets the typographic form
+// of the output
+func FmtOptTypography(t Typography) FormatterOpt {
+	return func(f *Formatter) error {
+		f.typography = t
+		return nil
+	}
+}
+
+const (
+	unicodeMinus     = "\u2212"
+	narrowNBSP       = "\u202f"
+	htmlNarrowNBSP   = "&#8239;"
+	superscriptMinus = '⁻'
+)
+
+// unspacedSymbols holds those symbols which, following the SI Brochure, are
+// written immediately after the number without an intervening space
+var unspacedSymbols = map[string]bool{
+	"°": true,
+	"′": true,
+	"″": true,
+}
+
+// superscriptValues maps superscript characters to the corresponding plain
+// characters
+var superscriptValues = map[rune]rune{
+	'⁰':              '0',
+	'¹':              '1',
+	'²':              '2',
+	'³':              '3',
+	'⁴':              '4',
+	'⁵':              '5',
+	'⁶':              '6',
+	'⁷':              '7',
+	'⁸':              '8',
+	'⁹':              '9',
+	superscriptMinus: '-',
+}
+
+// withUnicodeMinus replaces a leading hyphen with a Unicode minus sign
+func withUnicodeMinus(s string) string {
+	if rest, ok := strings.CutPrefix(s, "-"); ok {
+		return unicodeMinus + rest
+	}
+
+	return s
+}
+
+// convertSymbol converts the symbol by passing each run of superscript
+// characters (converted to plain characters) to the sup func and each other
+// character to the other func and concatenating the results.
+func convertSymbol(sym string,
+	sup func(string) string, other func(rune) string,
+) string {
+	var (
+		sb  strings.Builder
+		run strings.Builder
+	)
+
+	flushRun := func() {
+		if run.Len() > 0 {
+			sb.WriteString(sup(run.String()))
+			run.Reset()
+		}
+	}
+
+	for _, r := range sym {
+		if pr, ok := superscriptValues[r]; ok {
+			run.WriteRune(pr)
+			continue
+		}
+
+		flushRun()
+		sb.WriteString(other(r))
+	}
+
+	flushRun()
+
+	return sb.String()
+}
+
+// latexSpecialChars maps characters with a special meaning in LaTeX to
+// their escaped forms
+var latexSpecialChars = map[rune]string{
+	'%':  `\%`,
+	'#':  `\#`,
+	'&':  `\&`,
+	'_':  `\_`,
+	'$':  `\$`,
+	'{':  `\{`,
+	'}':  `\}`,
+	'\\': `\textbackslash{}`,
+	'^':  `\textasciicircum{}`,
+	'~':  `\textasciitilde{}`,
+}
+
+// latexUnit converts the unit symbol into a literal unit for the siunitx
+// package
+func latexUnit(sym string) string {
+	return convertSymbol(sym,
+		func(s string) string { return "^{" + s + "}" },
+		func(r rune) string {
+			switch r {
+			case '·':
+				return "."
+			case ' ':
+				return "~"
+			}
+
+			if s, ok := latexSpecialChars[r]; ok {
+				return s
+			}
+
+			return string(r)
+		})
+}
+
+// htmlUnit converts the unit symbol into HTML
+func htmlUnit(sym string) string {
+	return convertSymbol(sym,
+		func(s string) string {
+			return "<sup>" + withUnicodeMinus(s) + "</sup>"
+		},
+		func(r rune) string { return html.EscapeString(string(r)) })
+}
+
+// mantissaExp returns the number split into its mantissa and any power of
+// ten, including any exponent from engineering notation
+func (p fmtParts) mantissaExp() (string, int) {
+	mant, exp := p.num, p.exp
+
+	if i := strings.IndexAny(p.num, "eE"); i >= 0 {
+		mant = p.num[:i]
+		if e, err := strconv.Atoi(p.num[i+1:]); err == nil {
+			exp += e
+		}
+	}
+
+	return mant, exp
+}
+
+// renderUnicode combines the parts into Unicode text
+func (f *Formatter) renderUnicode(p fmtParts) string {
+	mant, exp := p.mantissaExp()
+
+	s := withUnicodeMinus(f.locale.localiseNumber(mant))
+	if exp != 0 {
+		s += "×10" + superscriptDigits.Replace(strconv.Itoa(exp))
+	}
+
+	s += p.prefix.Symbol()
+
+	sym := p.u.Symbol()
+	if sym == "" || unspacedSymbols[sym] {
+		return s + sym
+	}
+
+	return s + narrowNBSP + sym
+}
+
+// renderLaTeX combines the parts into LaTeX using the siunitx package. Any
+// SI prefix from the dimensionless Family is given as a power of ten. The
+// number is not localised; siunitx has its own options for that.
+func (f *Formatter) renderLaTeX(p fmtParts) string {
+	mant, exp := p.mantissaExp()
+	exp += p.prefixExp
+
+	num := mant
+	if exp != 0 {
+		num += "e" + strconv.Itoa(exp)
+	}
+
+	sym := p.u.Symbol()
+	if sym == "" {
+		return `\num{` + num + `}`
+	}
+
+	return `\qty{` + num + `}{` + siunitxUnit(p.u) + `}`
+}
+
+// renderHTML combines the parts into HTML
+func (f *Formatter) renderHTML(p fmtParts) string {
+	mant, exp := p.mantissaExp()
+
+	s := html.EscapeString(withUnicodeMinus(f.locale.localiseNumber(mant)))
+	if exp != 0 {
+		s += "×10<sup>" + withUnicodeMinus(strconv.Itoa(exp)) + "</sup>"
+	}
+
+	s += html.EscapeString(p.prefix.Symbol())
+
+	sym := p.u.Symbol()
+	if sym == "" || unspacedSymbols[sym] {
+		return s + htmlUnit(sym)
+	}
+
+	return s + htmlNarrowNBSP + htmlUnit(sym)
+}
+
+const (
+	mathMLStart    = `<math xmlns="http://www.w3.org/1998/Math/MathML">`
+	mathMLEnd      = `</math>`
+	mathMLThinSpan = `<mspace width="0.167em"/>`
+)
+
+// mathMLNumber returns the number as MathML, with any sign given as a
+// separate operator
+func mathMLNumber(num string) string {
+	if rest, ok := strings.CutPrefix(num, "-"); ok {
+		return "<mrow><mo>" + unicodeMinus + "</mo><mn>" +
+			html.EscapeString(rest) + "</mn></mrow>"
+	}
+
+	return "<mn>" + html.EscapeString(num) + "</mn>"
+}
+
+// mathMLIdentifier returns the text as a MathML identifier shown in an
+// upright font, as is proper for unit symbols
+func mathMLIdentifier(s string) string {
+	return `<mi mathvariant="normal">` + html.EscapeString(s) + "</mi>"
+}
+
+// mathMLUnit converts the unit symbol into MathML
+func mathMLUnit(sym string) string {
+	factors := []string{}
+
+	for factor := range strings.SplitSeq(sym, "·") {
+		base, power := factor, ""
+
+		if i := strings.IndexFunc(factor, func(r rune) bool {
+			_, ok := superscriptValues[r]
+			return ok
+		}); i > 0 {
+			base = factor[:i]
+			power = convertSymbol(factor[i:],
+				func(s string) string { return s },
+				func(r rune) string { return string(r) })
+		}
+
+		if power == "" {
+			factors = append(factors, mathMLIdentifier(base))
+			continue
+		}
+
+		factors = append(factors,
+			"<msup>"+mathMLIdentifier(base)+mathMLNumber(power)+"</msup>")
+	}
+
+	return strings.Join(factors, "<mo>·</mo>")
+}
+
+// renderMathML combines the parts into a MathML math element
+func (f *Formatter) renderMathML(p fmtParts) string {
+	mant, exp := p.mantissaExp()
+
+	var sb strings.Builder
+
+	sb.WriteString(mathMLStart)
+	sb.WriteString(mathMLNumber(f.locale.localiseNumber(mant)))
+
+	if exp != 0 {
+		sb.WriteString("<mo>×</mo><msup><mn>10</mn>" +
+			mathMLNumber(strconv.Itoa(exp)) + "</msup>")
+	}
+
+	if p.prefix.f != nil {
+		sb.WriteString(mathMLIdentifier(p.prefix.Symbol()))
+	}
+
+	if sym := p.u.Symbol(); sym != "" {
+		if !unspacedSymbols[sym] {
+			sb.WriteString(mathMLThinSpan)
+		}
+
+		sb.WriteString(mathMLUnit(sym))
+	}
+
+	sb.WriteString(mathMLEnd)
+
+	return sb.String()
+}
+
+// These Formatters are used by the ValUnit.LaTeX and ValUnit.MathML methods
+var (
+	latexFormatter  = NewFormatterOrPanic(FmtOptTypography(TypoLaTeX))
+	mathMLFormatter = NewFormatterOrPanic(FmtOptTypography(TypoMathML))
+)
+
+// LaTeX returns the ValUnit as LaTeX using the siunitx package, for
+// instance, \qty{12.5}{\kilo\metre}. See TypoLaTeX for details.
+func (v ValUnit) LaTeX() string {
+	return latexFormatter.Format(v)
+}
+
+// MathML returns the ValUnit as a MathML math element. See TypoMathML for
+// details.
+func (v ValUnit) MathML() string {
+	return mathMLFormatter.Format(v)
+}
@@ -0,0 +1,174 @@
+package units
+
+import (
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestSymbol(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		u      Unit
+		expSym string
+	}{
+		{
+			ID:     testhelper.MkID("same as abbreviation"),
+			u:      GetOrPanic(Distance, "metre"),
+			expSym: "m",
+		},
+		{
+			ID:     testhelper.MkID("different from abbreviation"),
+			u:      GetOrPanic(Velocity, "metre/second"),
+			expSym: "m·s⁻¹",
+		},
+		{
+			ID:     testhelper.MkID("found by alias"),
+			u:      GetOrPanic(Time, "seconds"),
+			expSym: "s",
+		},
+		{
+			ID:     testhelper.MkID("no family"),
+			u:      Unit{},
+			expSym: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "symbol", tc.u.Symbol(), tc.expSym)
+	}
+}
+
+func TestFormatterTypography(t *testing.T) {
+	mps := GetOrPanic(Velocity, "metre/second")
+	sqFt := GetOrPanic(Area, "square foot")
+	degree := GetOrPanic(Angle, "degree")
+	foot := GetOrPanic(Distance, "foot")
+	pctC := GetOrPanic(Velocity, "percentOfSpeedOfLight")
+	one := GetOrPanic(Dimensionless, "1")
+
+	testCases := []struct {
+		testhelper.ID
+		opts   []FormatterOpt
+		vu     ValUnit
+		expStr string
+	}{
+		{
+			ID:     testhelper.MkID("Unicode"),
+			opts:   []FormatterOpt{FmtOptTypography(TypoUnicode)},
+			vu:     ValUnit{V: -12.5, U: mps},
+			expStr: "−12.5\u202fm·s⁻¹",
+		},
+		{
+			ID:     testhelper.MkID("Unicode, no space"),
+			opts:   []FormatterOpt{FmtOptTypography(TypoUnicode)},
+			vu:     ValUnit{V: 30, U: degree},
+			expStr: "30°",
+		},
+		{
+			ID: testhelper.MkID("Unicode, engineering"),
+			opts: []FormatterOpt{
+				FmtOptTypography(TypoUnicode),
+				FmtOptEngineering(EngE),
+			},
+			vu:     ValUnit{V: 0.0000125, U: sqFt},
+			expStr: "12.5×10⁻⁶\u202fft²",
+		},
+		{
+			ID: testhelper.MkID("Unicode, dimensionless prefix"),
+			opts: []FormatterOpt{
+				FmtOptTypography(TypoUnicode),
+				FmtOptEngineering(EngSIPrefix),
+			},
+			vu:     ValUnit{V: 0.0000125, U: foot},
+			expStr: "12.5µ\u202fft",
+		},
+		{
+			ID:     testhelper.MkID("LaTeX"),
+			opts:   []FormatterOpt{FmtOptTypography(TypoLaTeX)},
+			vu:     ValUnit{V: -12.5, U: mps},
+			expStr: `\qty{-12.5}{\metre\per\second}`,
+		},
+		{
+			ID: testhelper.MkID("LaTeX, dimensionless prefix"),
+			opts: []FormatterOpt{
+				FmtOptTypography(TypoLaTeX),
+				FmtOptEngineering(EngSIPrefix),
+			},
+			vu:     ValUnit{V: 12300, U: foot},
+			expStr: `\qty{12.3e3}{ft}`,
+		},
+		{
+			ID: testhelper.MkID("LaTeX, exponent, not localised"),
+			opts: []FormatterOpt{
+				FmtOptTypography(TypoLaTeX),
+				FmtOptLocaleName(LocaleGerman),
+				FmtOptSigFigs(3),
+			},
+			vu:     ValUnit{V: 1.5e25, U: sqFt},
+			expStr: `\qty{1.50e25}{ft^{2}}`,
+		},
+		{
+			ID:     testhelper.MkID("LaTeX, special characters"),
+			opts:   []FormatterOpt{FmtOptTypography(TypoLaTeX)},
+			vu:     ValUnit{V: 5, U: pctC},
+			expStr: `\qty{5}{\%c}`,
+		},
+		{
+			ID:     testhelper.MkID("LaTeX, no symbol"),
+			opts:   []FormatterOpt{FmtOptTypography(TypoLaTeX)},
+			vu:     ValUnit{V: 5, U: one},
+			expStr: `\num{5}`,
+		},
+		{
+			ID:     testhelper.MkID("HTML"),
+			opts:   []FormatterOpt{FmtOptTypography(TypoHTML)},
+			vu:     ValUnit{V: -12.5, U: mps},
+			expStr: "−12.5&#8239;m·s<sup>−1</sup>",
+		},
+		{
+			ID: testhelper.MkID("HTML, engineering, localised"),
+			opts: []FormatterOpt{
+				FmtOptTypography(TypoHTML),
+				FmtOptEngineering(EngTimesTen),
+				FmtOptLocaleName(LocaleFrench),
+			},
+			vu:     ValUnit{V: 12500, U: sqFt},
+			expStr: "12,5×10<sup>3</sup>&#8239;ft<sup>2</sup>",
+		},
+		{
+			ID:   testhelper.MkID("MathML"),
+			opts: []FormatterOpt{FmtOptTypography(TypoMathML)},
+			vu:   ValUnit{V: -12.5, U: mps},
+			expStr: mathMLStart +
+				"<mrow><mo>−</mo><mn>12.5</mn></mrow>" + mathMLThinSpan +
+				`<mi mathvariant="normal">m</mi><mo>·</mo>` +
+				`<msup><mi mathvariant="normal">s</mi>` +
+				"<mrow><mo>−</mo><mn>1</mn></mrow></msup>" + mathMLEnd,
+		},
+		{
+			ID: testhelper.MkID("MathML, exponent"),
+			opts: []FormatterOpt{
+				FmtOptTypography(TypoMathML),
+				FmtOptEngineering(EngTimesTen),
+			},
+			vu: ValUnit{V: 12500, U: foot},
+			expStr: mathMLStart +
+				"<mn>12.5</mn><mo>×</mo><msup><mn>10</mn><mn>3</mn></msup>" +
+				mathMLThinSpan + `<mi mathvariant="normal">ft</mi>` + mathMLEnd,
+		},
+	}
+
+	for _, tc := range testCases {
+		f := NewFormatterOrPanic(tc.opts...)
+		testhelper.DiffString(t, tc.IDStr(), "formatted value",
+			f.Format(tc.vu), tc.expStr)
+	}
+}
+
+func TestTypographyString(t *testing.T) {
+	testhelper.DiffString(t, "TypoLaTeX", "string",
+		TypoLaTeX.String(), "LaTeX")
+	testhelper.DiffString(t, "bad Typography", "string",
+		Typography(99).String(), "Typography(99)")
+}
@@ -0,0 +1,477 @@
+package units
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/nickwells/mathutil.mod/v2/mathutil"
+)
+
+// ucumIndex maps UCUM codes to the corresponding Unit. It is populated from
+// the unitUCUM values in the entries in unitFamilies.
+var ucumIndex = map[string]Unit{}
+
+// populateUCUMIndex adds the UCUM codes of the Family to the index
+func (f *Family) populateUCUMIndex() {
+	for id, code := range f.unitUCUM {
+		u, ok := f.altUnits[id]
+		if !ok {
+			panic(
+				fmt.Errorf(
+					"the UCUM code %q on Family %q is for an unknown Unit: %q",
+					code, f.name, id))
+		}
+
+		if other, ok := ucumIndex[code]; ok {
+			panic(
+				fmt.Errorf(
+					"there is a duplicate UCUM code:"+
+						" Unit %q (%s) has the code %q and so does Unit %q (%s)",
+					id, f.name, code, other.id, other.f.name))
+		}
+
+		u.id = id
+		ucumIndex[code] = u
+	}
+}
+
+// ucumSynonyms maps UCUM codes which are accepted but never given to the
+// code which is given for the same unit. UCUM defines the year ("a") and
+// the month ("mo") to be the Julian year and month.
+var ucumSynonyms = map[string]string{
+	"a":  "a_j",
+	"mo": "mo_j",
+}
+
+// ucumLookup returns the unit having the UCUM code, which may be one of the
+// ucumSynonyms, and true. If there is no such unit it returns false.
+func ucumLookup(code string) (Unit, bool) {
+	if c, ok := ucumSynonyms[code]; ok {
+		code = c
+	}
+
+	u, ok := ucumIndex[code]
+
+	return u, ok
+}
+
+// UCUM returns the code for the Unit in the Unified Code for Units of
+// Measure (UCUM), for instance "[lb_av]" for the pound, and true. If the
+// Unit has no UCUM code it returns false.
+func (u Unit) UCUM() (string, bool) {
+	if u.f == nil {
+		return "", false
+	}
+
+	if u.f.rate != nil {
+		return u.f.rateUnitUCUM(u.id)
+	}
+
+	code, ok := u.f.unitUCUM[u.id]
+
+	return code, ok
+}
+
+// UCUM returns the ValUnit in UCUM form, the value followed by the UCUM
+// code of the unit, for instance "5 mg/dL". A non-nil error is returned if
+// the unit has no UCUM code.
+func (v ValUnit) UCUM() (string, error) {
+	code, ok := v.U.UCUM()
+	if !ok {
+		return "", fmt.Errorf("the unit %q has no UCUM code", v.U.id)
+	}
+
+	return strconv.FormatFloat(v.V, 'g', -1, 64) + " " + code, nil
+}
+
+// ucumRateCode returns the UCUM code for a rate given the codes of its
+// numerator and denominator
+func ucumRateCode(numCode, denCode string) string {
+	if strings.ContainsAny(denCode, "./") {
+		denCode = "(" + denCode + ")"
+	}
+
+	return numCode + "/" + denCode
+}
+
+// ucumPrefixes maps the UCUM metric prefixes to the power of ten they give
+var ucumPrefixes = map[string]int{
+	"y": -24, "z": -21, "a": -18, "f": -15, "p": -12, "n": -9, "u": -6,
+	"m": -3, "c": -2, "d": -1, "da": 1, "h": 2, "k": 3, "M": 6, "G": 9,
+	"T": 12, "P": 15, "E": 18, "Z": 21, "Y": 24,
+}
+
+// ucumBinaryPrefixes maps the UCUM binary prefixes to the power of two they
+// give
+var ucumBinaryPrefixes = map[string]int{
+	"Ki": 10, "Mi": 20, "Gi": 30, "Ti": 40,
+}
+
+// findUnitByFactor returns the unit in the Family having the given
+// conversion factor and no offsets. If there are several such units those
+// having a UCUM code are preferred and then the one with the first ID.
+func (f *Family) findUnitByFactor(factor float64) (Unit, bool) {
+	const epsilon = 1e-9
+
+	candidates := []Unit{}
+
+	for id, u := range f.altUnits {
+		if u.hasOffset() ||
+			!mathutil.AlmostEqual(u.convFactor/factor, 1, epsilon) {
+			continue
+		}
+
+		u.id = id
+		candidates = append(candidates, u)
+	}
+
+	if len(candidates) == 0 {
+		return Unit{}, false
+	}
+
+	slices.SortFunc(candidates, func(a, b Unit) int {
+		_, aHasCode := a.UCUM()
+		_, bHasCode := b.UCUM()
+
+		if aHasCode != bHasCode {
+			if aHasCode {
+				return -1
+			}
+
+			return 1
+		}
+
+		return strings.Compare(a.id, b.id)
+	})
+
+	return candidates[0], true
+}
+
+// ucumAtom returns the unit for the UCUM unit atom, which may have a
+// prefix. A non-nil error is returned if the atom is not supported.
+func ucumAtom(atom string) (Unit, error) {
+	if u, ok := ucumLookup(atom); ok {
+		return u, nil
+	}
+
+	type prefixed struct {
+		prefix string
+		mult   float64
+	}
+
+	tries := []prefixed{}
+	for p, exp := range ucumPrefixes {
+		tries = append(tries, prefixed{p, math.Pow10(exp)})
+	}
+
+	for p, exp := range ucumBinaryPrefixes {
+		tries = append(tries, prefixed{p, math.Exp2(float64(exp))})
+	}
+
+	// The prefixes are tried in a fixed order, longest first, so that, for
+	// instance, "da" is tried before "d" and the result does not depend on
+	// the order of iteration over the maps
+	slices.SortFunc(tries, func(a, b prefixed) int {
+		if n := len(b.prefix) - len(a.prefix); n != 0 {
+			return n
+		}
+
+		return strings.Compare(a.prefix, b.prefix)
+	})
+
+	for _, t := range tries {
+		rest, ok := strings.CutPrefix(atom, t.prefix)
+		if !ok {
+			continue
+		}
+
+		root, ok := ucumLookup(rest)
+		if !ok || root.hasOffset() {
+			continue
+		}
+
+		if u, ok := root.f.findUnitByFactor(root.convFactor * t.mult); ok {
+			return u, nil
+		}
+	}
+
+	return Unit{}, fmt.Errorf("the UCUM unit %q is not supported", atom)
+}
+
+// ucumFactor is a unit raised to a power, a part of a UCUM code
+type ucumFactor struct {
+	u   Unit
+	exp int
+}
+
+var (
+	ucumAnnotationRE = regexp.MustCompile(`\{[^}]*\}`)
+	ucumExponentRE   = regexp.MustCompile(`^(.*[^-+0-9])([-+]?[0-9]+)$`)
+	ucumNumberRE     = regexp.MustCompile(`^[0-9]+$`)
+)
+
+// ucumSimpleUnit returns the factors for a UCUM simple unit: an optional
+// prefix and an atom followed by an optional exponent. Any annotations are
+// ignored.
+func ucumSimpleUnit(s string) ([]ucumFactor, error) {
+	s = ucumAnnotationRE.ReplaceAllString(s, "")
+	if s == "" || s == "1" {
+		return nil, nil
+	}
+
+	if ucumNumberRE.MatchString(s) {
+		return nil, fmt.Errorf("the UCUM number %q is not supported", s)
+	}
+
+	atom, exp := s, 1
+
+	if parts := ucumExponentRE.FindStringSubmatch(s); parts != nil {
+		if u, err := ucumAtom(s); err == nil {
+			return []ucumFactor{{u: u, exp: 1}}, nil
+		}
+
+		atom = parts[1]
+
+		var err error
+
+		exp, err = strconv.Atoi(parts[2])
+		if err != nil {
+			return nil, fmt.Errorf("bad UCUM exponent in %q: %w", s, err)
+		}
+	}
+
+	u, err := ucumAtom(atom)
+	if err != nil {
+		return nil, err
+	}
+
+	return []ucumFactor{{u: u, exp: exp}}, nil
+}
+
+// ucumParser parses a UCUM code into the factors making it up
+type ucumParser struct {
+	code string
+	pos  int
+}
+
+// atEnd returns true if the parser has reached the end of the code or of a
+// parenthesised term
+func (p *ucumParser) atEnd() bool {
+	return p.pos >= len(p.code) || p.code[p.pos] == ')'
+}
+
+// term parses a sequence of components separated by '.' (multiplication)
+// or '/' (division), possibly starting with a '/'
+func (p *ucumParser) term() ([]ucumFactor, error) {
+	factors := []ucumFactor{}
+	sign := 1
+
+	if strings.HasPrefix(p.code[p.pos:], "/") {
+		sign = -1
+		p.pos++
+	}
+
+	for {
+		fs, err := p.component()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, f := range fs {
+			factors = append(factors, ucumFactor{u: f.u, exp: sign * f.exp})
+		}
+
+		if p.atEnd() {
+			return factors, nil
+		}
+
+		switch p.code[p.pos] {
+		case '.':
+			sign = 1
+		case '/':
+			sign = -1
+		default:
+			return nil, fmt.Errorf("unexpected %q in the UCUM code %q",
+				p.code[p.pos], p.code)
+		}
+
+		p.pos++
+	}
+}
+
+// component parses either a parenthesised term or a simple unit
+func (p *ucumParser) component() ([]ucumFactor, error) {
+	if strings.HasPrefix(p.code[p.pos:], "(") {
+		p.pos++
+
+		fs, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+
+		if !strings.HasPrefix(p.code[p.pos:], ")") {
+			return nil, fmt.Errorf("there is no closing ')' in the UCUM code %q",
+				p.code)
+		}
+
+		p.pos++
+
+		return fs, nil
+	}
+
+	start := p.pos
+	depth := 0
+
+scan:
+	for ; p.pos < len(p.code); p.pos++ {
+		switch p.code[p.pos] {
+		case '[', '{':
+			depth++
+		case ']', '}':
+			depth--
+		case '.', '/', '(', ')':
+			if depth == 0 {
+				break scan
+			}
+		}
+	}
+
+	if p.pos == start {
+		return nil, fmt.Errorf("a unit is missing in the UCUM code %q", p.code)
+	}
+
+	return ucumSimpleUnit(p.code[start:p.pos])
+}
+
+// combineUCUMFactors merges any factors having the same unit and removes
+// any with a zero exponent
+func combineUCUMFactors(factors []ucumFactor) []ucumFactor {
+	rval := []ucumFactor{}
+
+	for _, f := range factors {
+		i := slices.IndexFunc(rval, func(r ucumFactor) bool {
+			return r.u.f == f.u.f && r.u.id == f.u.id
+		})
+		if i < 0 {
+			rval = append(rval, f)
+			continue
+		}
+
+		rval[i].exp += f.exp
+	}
+
+	return slices.DeleteFunc(rval, func(f ucumFactor) bool {
+		return f.exp == 0
+	})
+}
+
+// powerFamilies maps the powers of a unit of distance to the Family of
+// units of that dimension
+var powerFamilies = map[int]*Family{
+	2: areaFamily,
+	3: volumeFamily,
+}
+
+// distancePower replaces a unit of distance raised to the power of two or
+// three with the corresponding unit of area or volume. It returns false if
+// there is no such unit.
+func distancePower(f ucumFactor) (ucumFactor, bool) {
+	if f.u.f != distanceFamily {
+		return f, true
+	}
+
+	absExp := f.exp
+	if absExp < 0 {
+		absExp = -absExp
+	}
+
+	pf, ok := powerFamilies[absExp]
+	if !ok {
+		return f, true
+	}
+
+	u, ok := pf.findUnitByFactor(math.Pow(f.u.convFactor, float64(absExp)))
+	if !ok {
+		return f, false
+	}
+
+	return ucumFactor{u: u, exp: f.exp / absExp}, true
+}
+
+// GetUCUM returns the Unit for the UCUM code. The code may be that of a
+// unit (see Unit.UCUM) or may be a compound code following the UCUM grammar
+// of prefixes, atoms, exponents, products and quotients, for instance
+// "mg/dL" or "[ft_i]2". Annotations, such as "{tablets}", are ignored. A
+// quotient is given as a unit of a rate Family (see GetFamily).
+//
+// A non-nil error is returned if the code uses an unsupported atom or
+// cannot be given as a single Unit.
+func GetUCUM(code string) (Unit, error) {
+	if u, ok := ucumLookup(code); ok {
+		return u, nil
+	}
+
+	if code == "" {
+		return Unit{}, errors.New("the UCUM code is empty")
+	}
+
+	p := &ucumParser{code: code}
+
+	factors, err := p.term()
+	if err != nil {
+		return Unit{}, err
+	}
+
+	if p.pos < len(p.code) {
+		return Unit{}, fmt.Errorf("unexpected %q in the UCUM code %q",
+			p.code[p.pos], p.code)
+	}
+
+	var num, den []ucumFactor
+
+	for _, f := range combineUCUMFactors(factors) {
+		f, ok := distancePower(f)
+		if !ok {
+			return Unit{},
+				fmt.Errorf("there is no unit matching the UCUM code %q", code)
+		}
+
+		switch f.exp {
+		case 1:
+			num = append(num, f)
+		case -1:
+			den = append(den, f)
+		default:
+			return Unit{},
+				fmt.Errorf("the UCUM code %q cannot be given as a single unit",
+					code)
+		}
+	}
+
+	switch {
+	case len(num) == 1 && len(den) == 0:
+		return num[0].u, nil
+	case len(num) == 1 && len(den) == 1:
+		return GetStrict(num[0].u.f.name+rateSep+den[0].u.f.name,
+			num[0].u.id+rateSep+den[0].u.id)
+	}
+
+	return Unit{},
+		fmt.Errorf("the UCUM code %q cannot be given as a single unit", code)
+}
+
+// GetUCUMOrPanic calls GetUCUM and panics if the error is non-nil,
+// otherwise it returns the Unit.
+func GetUCUMOrPanic(code string) Unit {
+	u, err := GetUCUM(code)
+	if err != nil {
+		panic(err)
+	}
+
+	return u
+}
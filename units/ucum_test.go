@@ -0,0 +1,220 @@
+package units
+
+import (
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestUCUMCodes(t *testing.T) {
+	for _, f := range unitFamilies {
+		for id, code := range f.unitUCUM {
+			u, err := GetUCUM(code)
+			if err != nil {
+				t.Errorf("%s: %q: unexpected error: %s", f.name, code, err)
+				continue
+			}
+
+			if u.f != f || u.id != id {
+				t.Errorf("%s: %q: expected unit %q, got %q (%s)",
+					f.name, code, id, u.id, u.f.name)
+			}
+
+			gotCode, ok := u.UCUM()
+			if !ok || gotCode != code {
+				t.Errorf("%s: %q: the unit has the code %q (%t)",
+					f.name, code, gotCode, ok)
+			}
+		}
+	}
+}
+
+func TestGetUCUM(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		code    string
+		expFam  string
+		expID   string
+		expCode string
+	}{
+		{
+			ID:     testhelper.MkID("simple atom"),
+			code:   "[in_i]",
+			expFam: "distance",
+			expID:  "inch",
+		},
+		{
+			ID:     testhelper.MkID("pound"),
+			code:   "[lb_av]",
+			expFam: "mass",
+			expID:  "pound",
+		},
+		{
+			ID:     testhelper.MkID("Celsius"),
+			code:   "Cel",
+			expFam: "temperature",
+			expID:  "C",
+		},
+		{
+			ID:      testhelper.MkID("prefixed atom not in the table"),
+			code:    "Mg",
+			expFam:  "mass",
+			expID:   "tonne",
+			expCode: "t",
+		},
+		{
+			ID:      testhelper.MkID("binary prefix"),
+			code:    "GiBy",
+			expFam:  "data",
+			expID:   "GiB",
+			expCode: "GiBy",
+		},
+		{
+			ID:      testhelper.MkID("two letter prefix"),
+			code:    "dabar",
+			expFam:  "pressure",
+			expID:   "MPa",
+			expCode: "MPa",
+		},
+		{
+			ID:      testhelper.MkID("year"),
+			code:    "a",
+			expFam:  "time",
+			expID:   "Julian year",
+			expCode: "a_j",
+		},
+		{
+			ID:      testhelper.MkID("month"),
+			code:    "mo",
+			expFam:  "time",
+			expID:   "Julian month",
+			expCode: "mo_j",
+		},
+		{
+			ID:      testhelper.MkID("months per year"),
+			code:    "mo/a",
+			expFam:  "time/time",
+			expID:   "Julian month/Julian year",
+			expCode: "mo_j/a_j",
+		},
+		{
+			ID:      testhelper.MkID("distance squared"),
+			code:    "[ft_i]2",
+			expFam:  "area",
+			expID:   "square foot",
+			expCode: "[sft_i]",
+		},
+		{
+			ID:      testhelper.MkID("distance cubed, with annotation"),
+			code:    "m3{water}",
+			expFam:  "volume",
+			expID:   "cubic metre",
+			expCode: "m3",
+		},
+		{
+			ID:      testhelper.MkID("quotient"),
+			code:    "mg/dL",
+			expFam:  "mass/volume",
+			expID:   "mg/dl",
+			expCode: "mg/dL",
+		},
+		{
+			ID:      testhelper.MkID("quotient by a negative exponent"),
+			code:    "kg.L-1",
+			expFam:  "mass/volume",
+			expID:   "kg/litre",
+			expCode: "kg/L",
+		},
+		{
+			ID:      testhelper.MkID("leading slash and parentheses"),
+			code:    "/(s/[lb_av])",
+			expFam:  "mass/time",
+			expID:   "pound/second",
+			expCode: "[lb_av]/s",
+		},
+		{
+			ID:      testhelper.MkID("cancelling units"),
+			code:    "km.s/s",
+			expFam:  "distance",
+			expID:   "km",
+			expCode: "km",
+		},
+		{
+			ID:     testhelper.MkID("empty"),
+			ExpErr: testhelper.MkExpErr("the UCUM code is empty"),
+		},
+		{
+			ID:     testhelper.MkID("unsupported atom"),
+			code:   "mol/L",
+			ExpErr: testhelper.MkExpErr(`the UCUM unit "mol" is not supported`),
+		},
+		{
+			ID:     testhelper.MkID("number"),
+			code:   "10.m",
+			ExpErr: testhelper.MkExpErr(`the UCUM number "10" is not supported`),
+		},
+		{
+			ID:   testhelper.MkID("not a single unit"),
+			code: "m.kg",
+			ExpErr: testhelper.MkExpErr(
+				`the UCUM code "m.kg" cannot be given as a single unit`),
+		},
+		{
+			ID:   testhelper.MkID("unbalanced parentheses"),
+			code: "(m/s",
+			ExpErr: testhelper.MkExpErr(
+				`there is no closing ')' in the UCUM code "(m/s"`),
+		},
+	}
+
+	for _, tc := range testCases {
+		u, err := GetUCUM(tc.code)
+		if testhelper.CheckExpErr(t, err, tc) && err == nil {
+			testhelper.DiffString(t, tc.IDStr(), "family", u.f.name, tc.expFam)
+			testhelper.DiffString(t, tc.IDStr(), "unit ID", u.id, tc.expID)
+
+			expCode := tc.expCode
+			if expCode == "" {
+				expCode = tc.code
+			}
+
+			code, _ := u.UCUM()
+			testhelper.DiffString(t, tc.IDStr(), "UCUM code", code, expCode)
+		}
+	}
+}
+
+func TestValUnitUCUM(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		vu     ValUnit
+		expStr string
+	}{
+		{
+			ID:     testhelper.MkID("rate"),
+			vu:     ValUnit{V: 95, U: GetUCUMOrPanic("mg/dL")},
+			expStr: "95 mg/dL",
+		},
+		{
+			ID:     testhelper.MkID("temperature"),
+			vu:     ValUnit{V: -3.5, U: temperatureFamily.GetUnitOrPanic("C")},
+			expStr: "-3.5 Cel",
+		},
+		{
+			ID: testhelper.MkID("no code"),
+			vu: ValUnit{V: 1, U: velocityFamily.GetUnitOrPanic(
+				"percentOfSpeedOfLight")},
+			ExpErr: testhelper.MkExpErr(
+				`the unit "percentOfSpeedOfLight" has no UCUM code`),
+		},
+	}
+
+	for _, tc := range testCases {
+		s, err := tc.vu.UCUM()
+		if testhelper.CheckExpErr(t, err, tc) && err == nil {
+			testhelper.DiffString(t, tc.IDStr(), "UCUM", s, tc.expStr)
+		}
+	}
+}
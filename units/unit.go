@@ -136,6 +136,20 @@ func (u Unit) Abbrev() string {
 	return u.abbrev
 }
 
+// Symbol returns the typographic symbol for the unit. This may use
+// characters, such as superscript digits or the micro sign, which are not
+// used in the abbreviation. If the unit has no distinct symbol the
+// abbreviation is returned.
+func (u Unit) Symbol() string {
+	if u.f != nil {
+		if sym, ok := u.f.unitSymbols[u.id]; ok {
+			return sym
+		}
+	}
+
+	return u.abbrev
+}
+
 // Name returns the name of the unit (in singular form)
 func (u Unit) Name() string {
 	return u.name
@@ -163,7 +163,22 @@ func (v ValUnit) fFormat(f fmt.State) {
 	fmt.Fprintf(f, numFmt, v.V)
 }
 
-// Format provides a custom formatter for a ValUnit
+// lFormat performs the formatting for the 'l' verb
+func (v ValUnit) lFormat(f fmt.State) {
+	fmtStrStart, _ := makeFmtStart(f)
+
+	fmtr := DefaultFormatter()
+	if prec, ok := f.Precision(); ok {
+		fmtr = fmtr.withPrecision(prec)
+	}
+
+	fmt.Fprintf(f, fmtStrStart+"s", fmtr.Format(v))
+}
+
+// Format provides a custom formatter for a ValUnit. As well as the usual
+// verbs, the 'l' verb formats the value using the DefaultFormatter so that
+// it is shown according to the rules of the Formatter's Locale. If a
+// precision is given it sets the number of digits after the decimal point.
 func (v ValUnit) Format(f fmt.State, verb rune) {
 	switch verb {
 	case 's':
@@ -178,6 +193,8 @@ func (v ValUnit) Format(f fmt.State, verb rune) {
 		v.vFormat(f)
 	case 'f':
 		v.fFormat(f)
+	case 'l':
+		v.lFormat(f)
 	default:
 		_, _ = f.Write([]byte("%!" +
 			string(verb) +
@@ -111,3 +111,46 @@ var velocityNames = map[string]Unit{
 		"", "",
 	},
 }
+
+// velocityProvenance records the source of the conversion factor for each
+// unit of velocity
+var velocityProvenance = map[string]Provenance{
+	bunVelocity:             provSI,
+	"kilometre/hour":        provSI,
+	"foot/second":           provIntlYard,
+	"mile/hour":             provIntlYard,
+	"percentOfSpeedOfLight": provSI,
+	"knot":                  provIHC1929,
+}
+
+// velocitySymbols records the typographic symbols of those units of
+// velocity whose symbols differ from their abbreviations
+var velocitySymbols = map[string]string{
+	bunVelocity:      "m·s⁻¹",
+	"kilometre/hour": "km·h⁻¹",
+	"foot/second":    "ft·s⁻¹",
+}
+
+// velocityUCUM records the UCUM codes of those units of velocity which have
+// one
+var velocityUCUM = map[string]string{
+	bunVelocity:      "m/s",
+	"kilometre/hour": "km/h",
+	"foot/second":    "[ft_i]/s",
+	"mile/hour":      "[mi_i]/h",
+	"knot":           "[kn_i]",
+}
+
+// velocityExternalIDs records the identifiers of the units of velocity in
+// external schemes (see Unit.ExternalIDs)
+var velocityExternalIDs = map[string]map[string]string{
+	bunVelocity: {
+		SchemeQUDT: "M-PER-SEC", SchemeWikidata: "Q182429",
+		SchemeOM: "metrePerSecond-Time",
+	},
+	"kilometre/hour": {
+		SchemeQUDT: "KiloM-PER-HR", SchemeWikidata: "Q180154",
+		SchemeOM: "kilometrePerHour",
+	},
+	"knot": {SchemeQUDT: "KN", SchemeWikidata: "Q128822"},
+}
@@ -970,3 +970,194 @@ var volumeNames = map[string]Unit{
 		"", "",
 	},
 }
+
+// volumeProvenance records the source of the conversion factor for each
+// unit of volume
+var volumeProvenance = map[string]Provenance{
+	bunVolume: provSI,
+	"yl":      provSI,
+	"zl":      provSI,
+	"al":      provSI,
+	"fl":      provSI,
+	"pl":      provSI,
+	"nl":      provSI,
+	"ul":      provSI,
+	"ml":      provSI,
+	"cl":      provSI,
+	"dl":      provSI,
+	"litre":   provSI,
+	"dal":     provSI,
+	"hl":      provSI,
+	"kl":      provSI,
+	"Ml":      provSI,
+	"Gl":      provSI,
+	"Tl":      provSI,
+	"Pl":      provSI,
+	"El":      provSI,
+	"Zl":      provSI,
+	"Yl":      provSI,
+
+	"anker": provHistorical,
+
+	"bottle-wine":    {Kind: ConvExact, Source: SrcEUWineBottles},
+	"magnum":         provColloquial,
+	"marie-jeanne":   provColloquial,
+	"jeroboam":       provColloquial,
+	"rehoboam":       provColloquial,
+	"methuselah":     provColloquial,
+	"salmanazar":     provColloquial,
+	"balthazar":      provColloquial,
+	"nebuchadnezzar": provColloquial,
+
+	"cubic inch":      provIntlYard,
+	"cubic foot":      provIntlYard,
+	"cubic yard":      provIntlYard,
+	"perch (masonry)": provHistorical,
+
+	"minim":         provWMA1985,
+	"fluid-scruple": provWMA1985,
+	"fluid-drachm":  provWMA1985,
+	"fluid-ounce":   provWMA1985,
+	"gill":          provWMA1985,
+	"pint":          provWMA1985,
+	"quart":         provWMA1985,
+	"gallon":        provWMA1985,
+	"pin":           provColloquial,
+	"firkin":        provColloquial,
+	"kilderkin":     provColloquial,
+	"barrel":        provColloquial,
+	"hogshead":      provColloquial,
+	"peck":          provWMA1985,
+	"bushel":        provWMA1985,
+
+	"teaspoon":              provColloquial,
+	"tablespoon":            provColloquial,
+	"Australian tablespoon": provColloquial,
+
+	"US teaspoon":    provNISTHB44,
+	"US tablespoon":  provNISTHB44,
+	"US-fluid-ounce": provNISTHB44,
+	"US-shot":        provColloquial,
+	"US-gill":        provNISTHB44,
+	"US-cup":         provNISTHB44,
+	"US-pint":        provNISTHB44,
+	"US-quart":       provNISTHB44,
+	"US-gallon":      provNISTHB44,
+	"bbl":            provNISTHB44,
+	"Mbbl":           provNISTHB44,
+	"MMbbl":          provNISTHB44,
+	"Gbbl":           provNISTHB44,
+	"US-dry-gallon":  provNISTHB44,
+	"US-bushel":      provNISTHB44,
+	"US-dry-pint":    provNISTHB44,
+	"wine-gallon":    provHistorical,
+
+	"20ft shipping container": {
+		Kind: ConvConventional, Source: SrcISO668, SigDigits: 3,
+	},
+}
+
+// volumeSymbols records the typographic symbols of those units of volume
+// whose symbols differ from their abbreviations
+var volumeSymbols = map[string]string{
+	"ul": "µl",
+}
+
+// volumeUCUM records the UCUM codes of those units of volume which have one
+var volumeUCUM = map[string]string{
+	"yl":      "yL",
+	"zl":      "zL",
+	"al":      "aL",
+	"fl":      "fL",
+	"pl":      "pL",
+	"nl":      "nL",
+	"ul":      "uL",
+	"ml":      "mL",
+	"cl":      "cL",
+	"dl":      "dL",
+	"litre":   "L",
+	"dal":     "daL",
+	"hl":      "hL",
+	"kl":      "kL",
+	"Ml":      "ML",
+	"Gl":      "GL",
+	"Tl":      "TL",
+	"Pl":      "PL",
+	"El":      "EL",
+	"Zl":      "ZL",
+	"Yl":      "YL",
+	bunVolume: "m3",
+
+	"cubic inch":     "[cin_i]",
+	"cubic foot":     "[cft_i]",
+	"cubic yard":     "[cyd_i]",
+	"teaspoon":       "[tsp_m]",
+	"tablespoon":     "[tbs_m]",
+	"US teaspoon":    "[tsp_us]",
+	"US tablespoon":  "[tbs_us]",
+	"US-fluid-ounce": "[foz_us]",
+	"US-gill":        "[gil_us]",
+	"US-cup":         "[cup_us]",
+	"US-pint":        "[pt_us]",
+	"US-dry-pint":    "[dpt_us]",
+	"US-quart":       "[qt_us]",
+	"US-gallon":      "[gal_us]",
+	"US-dry-gallon":  "[gal_wi]",
+	"US-bushel":      "[bu_us]",
+	"bbl":            "[bbl_us]",
+	"minim":          "[min_br]",
+	"fluid-drachm":   "[fdr_br]",
+	"fluid-ounce":    "[foz_br]",
+	"gill":           "[gil_br]",
+	"pint":           "[pt_br]",
+	"quart":          "[qt_br]",
+	"gallon":         "[gal_br]",
+	"peck":           "[pk_br]",
+	"bushel":         "[bu_br]",
+}
+
+// volumeRec20 records the UN/ECE Recommendation 20 codes of those units of
+// volume which have one
+var volumeRec20 = map[string]string{
+	"ul":      "4G",
+	"ml":      "MLT",
+	"cl":      "CLT",
+	"dl":      "DLT",
+	"litre":   "LTR",
+	"hl":      "HLT",
+	"Ml":      "MAL",
+	bunVolume: "MTQ",
+
+	"cubic inch": "INQ",
+	"cubic foot": "FTQ",
+	"cubic yard": "YDQ",
+
+	"fluid-ounce": "OZI",
+	"gill":        "GII",
+	"pint":        "PTI",
+	"quart":       "QTI",
+	"gallon":      "GLI",
+	"bushel":      "BUI",
+
+	"US tablespoon":  "G24",
+	"US teaspoon":    "G25",
+	"US-fluid-ounce": "OZA",
+	"US-gill":        "GIA",
+	"US-cup":         "G21",
+	"US-pint":        "PTL",
+	"US-quart":       "QTL",
+	"US-gallon":      "GLL",
+	"bbl":            "BLL",
+	"US-dry-gallon":  "GLD",
+	"US-bushel":      "BUA",
+	"US-dry-pint":    "PTD",
+}
+
+// volumeExternalIDs records the identifiers of the units of volume in
+// external schemes (see Unit.ExternalIDs)
+var volumeExternalIDs = map[string]map[string]string{
+	bunVolume: {
+		SchemeQUDT: "M3", SchemeWikidata: "Q25517", SchemeOM: "cubicMetre",
+	},
+	"litre": {SchemeQUDT: "L", SchemeWikidata: "Q11582", SchemeOM: "litre"},
+}